@@ -0,0 +1,95 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+// BatchModInverse sets each dst[i] to the multiplicative inverse of src[i]
+// modulo m, and returns true. It uses Montgomery's trick, which replaces n
+// inversions with a single ModInverse and 3(n-1) modular multiplications.
+// mu must be the reciprocal of m, as computed by Reciprocal. dst and src must
+// have the same length, and may be the same slice.
+//
+// Elements which are 0 mod m have no inverse; their dst entry is set to 0 and
+// they do not affect the others. If any other element has no inverse, every
+// entry of dst is set to 0 and false is returned.
+// If m == 0, dst is set to 0 and false is returned (OBS: differs from the big.Int)
+func BatchModInverse(dst, src []Int, m *Int, mu *[5]uint64) bool {
+	if len(dst) != len(src) {
+		panic("uint256: BatchModInverse slice lengths differ")
+	}
+	if m.IsZero() {
+		clearInts(dst)
+		return false
+	}
+	// dst[i] first holds the product of the non-zero elements before src[i].
+	// The elements are read back from src on the way back, so src is copied
+	// if it is about to be overwritten.
+	if len(src) > 0 && &dst[0] == &src[0] {
+		src = append([]Int(nil), src...)
+	}
+	var (
+		acc = Int{1}
+		val Int
+	)
+	for i := range src {
+		dst[i] = acc
+		if !val.Mod(&src[i], m).IsZero() {
+			acc.MulModWithReciprocal(&acc, &val, m, mu)
+		}
+	}
+	var inv Int
+	if _, ok := inv.ModInverse(&acc, m); !ok {
+		clearInts(dst)
+		return false
+	}
+	// Walking back, inv is the inverse of the product of the non-zero
+	// elements up to and including src[i].
+	for i := len(src) - 1; i >= 0; i-- {
+		if val.Mod(&src[i], m).IsZero() {
+			dst[i].Clear()
+			continue
+		}
+		dst[i].MulModWithReciprocal(&inv, &dst[i], m, mu)
+		inv.MulModWithReciprocal(&inv, &val, m, mu)
+	}
+	return true
+}
+
+// clearInts sets every element of s to 0
+func clearInts(s []Int) {
+	for i := range s {
+		s[i].Clear()
+	}
+}
+
+// ModDotProduct sets z to the sum of x[i]*y[i] modulo m, and returns z.
+// The products are accumulated in 512 bits and only reduced when the sum
+// would overflow, and once at the end. mu must be the reciprocal of m, as
+// computed by Reciprocal. x and y must have the same length.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) ModDotProduct(x, y []Int, m *Int, mu *[5]uint64) *Int {
+	if len(x) != len(y) {
+		panic("uint256: ModDotProduct slice lengths differ")
+	}
+	if m.IsZero() {
+		return z.Clear()
+	}
+	var acc Uint512
+	for i := range x {
+		var (
+			p   Uint512
+			sum Uint512
+		)
+		p.MulFull(&x[i], &y[i])
+		if _, overflow := sum.AddOverflow(&acc, &p); overflow {
+			// Bring acc below m < 2^256 first. As p <= (2^256-1)^2, the sum
+			// then fits in 512 bits.
+			var r Int
+			r.reduce((*[8]uint64)(&acc), m, mu)
+			sum.AddOverflow(acc.SetInt(&r), &p)
+		}
+		acc = sum
+	}
+	return z.reduce((*[8]uint64)(&acc), m, mu)
+}
@@ -0,0 +1,109 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBatchModInverse(t *testing.T) {
+	moduli := []*Int{
+		MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+		MustFromHex("0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47"),
+		NewInt(1000003),
+		NewInt(1),
+	}
+	for _, m := range moduli {
+		mu := Reciprocal(m)
+		for n := 0; n < 20; n++ {
+			src := make([]Int, n)
+			for i := range src {
+				src[i] = *randNum()
+				if i%7 == 3 {
+					// Multiples of m are skipped
+					src[i].Mul(m, NewInt(uint64(i)))
+				}
+			}
+			dst := make([]Int, n)
+			if !BatchModInverse(dst, src, m, &mu) {
+				t.Fatalf("BatchModInverse(%d elements, %v) failed", n, m.Hex())
+			}
+			for i := range src {
+				want := new(big.Int).ModInverse(src[i].ToBig(), m.ToBig())
+				if want == nil || m.isOne() {
+					want = new(big.Int)
+				}
+				if !dst[i].Eq(MustFromBig(want)) {
+					t.Fatalf("BatchModInverse(%v, %v) [%d]\nwant : %#x\nhave : %#x", src[i].Hex(), m.Hex(), i, want, &dst[i])
+				}
+			}
+			// In place
+			if !BatchModInverse(src, src, m, &mu) {
+				t.Fatalf("BatchModInverse in place (%d elements, %v) failed", n, m.Hex())
+			}
+			for i := range src {
+				if !src[i].Eq(&dst[i]) {
+					t.Fatalf("BatchModInverse in place [%d]\nwant : %#x\nhave : %#x", i, &dst[i], &src[i])
+				}
+			}
+		}
+	}
+	// A single element without inverse fails the whole batch
+	var (
+		m   = NewInt(1 << 20)
+		mu  = Reciprocal(m)
+		src = []Int{{3}, {5}, {6}, {7}}
+		dst = []Int{{1}, {1}, {1}, {1}}
+	)
+	if BatchModInverse(dst, src, m, &mu) {
+		t.Fatalf("BatchModInverse with even element mod %v succeeded", m.Hex())
+	}
+	for i := range dst {
+		if !dst[i].IsZero() {
+			t.Fatalf("BatchModInverse failed, dst[%d] = %v", i, dst[i].Hex())
+		}
+	}
+	dst[0].SetOne()
+	if BatchModInverse(dst, src, new(Int), &mu) || !dst[0].IsZero() {
+		t.Fatalf("BatchModInverse with m == 0: have %v", dst[0].Hex())
+	}
+}
+
+func TestModDotProduct(t *testing.T) {
+	moduli := []*Int{
+		MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+		new(Int).SetAllOne(),
+		MustFromHex("0x1000000000000000000000000000000000000000000000001"),
+		NewInt(1000003),
+		NewInt(1),
+	}
+	for _, m := range moduli {
+		mu := Reciprocal(m)
+		for n := 0; n < 40; n++ {
+			var (
+				x    = make([]Int, n)
+				y    = make([]Int, n)
+				want = new(big.Int)
+			)
+			for i := range x {
+				x[i], y[i] = *randNum(), *randNum()
+				if i%5 == 0 {
+					// Largest products, to overflow the accumulator
+					x[i].SetAllOne()
+					y[i].SetAllOne()
+				}
+				want.Add(want, new(big.Int).Mul(x[i].ToBig(), y[i].ToBig()))
+			}
+			want.Mod(want, m.ToBig())
+			if have := new(Int).ModDotProduct(x, y, m, &mu); !have.Eq(MustFromBig(want)) {
+				t.Fatalf("ModDotProduct(%d elements, %v)\nwant : %#x\nhave : %#x", n, m.Hex(), want, have)
+			}
+		}
+	}
+	if have := NewInt(1).ModDotProduct([]Int{{1}}, []Int{{1}}, new(Int), nil); !have.IsZero() {
+		t.Fatalf("ModDotProduct with m == 0: have %v", have.Hex())
+	}
+}
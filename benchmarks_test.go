@@ -354,6 +354,64 @@ func BenchmarkXor(bench *testing.B) {
 	bench.Run("single/uint256", benchmark_Xor_Bit)
 }
 
+func BenchmarkBitOps(b *testing.B) {
+	benchmarkUint256 := func(b *testing.B, op func(z, x *Int, n uint)) {
+		var z Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				op(&z, &int256Samples[i], uint(i%256))
+			}
+		}
+	}
+	benchmarkBig := func(b *testing.B, op func(z, x *big.Int, n int)) {
+		var z big.Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				op(&z, &big256Samples[i], i%256)
+			}
+		}
+	}
+	b.Run("LeadingZeros/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { _ = x.LeadingZeros() })
+	})
+	b.Run("LeadingZeros/big", func(b *testing.B) {
+		benchmarkBig(b, func(z, x *big.Int, n int) { _ = 256 - x.BitLen() })
+	})
+	b.Run("TrailingZeros/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { _ = x.TrailingZeros() })
+	})
+	b.Run("TrailingZeros/big", func(b *testing.B) {
+		benchmarkBig(b, func(z, x *big.Int, n int) { _ = x.TrailingZeroBits() })
+	})
+	b.Run("OnesCount/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { _ = x.OnesCount() })
+	})
+	b.Run("Bit/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { _ = x.Bit(n) })
+	})
+	b.Run("Bit/big", func(b *testing.B) {
+		benchmarkBig(b, func(z, x *big.Int, n int) { _ = x.Bit(n) })
+	})
+	b.Run("SetBit/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { z.SetBit(x, n, 1) })
+	})
+	b.Run("SetBit/big", func(b *testing.B) {
+		benchmarkBig(b, func(z, x *big.Int, n int) { z.SetBit(x, n, 1) })
+	})
+	b.Run("AndNot/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { z.AndNot(x, &int256Samples[n]) })
+	})
+	b.Run("AndNot/big", func(b *testing.B) {
+		benchmarkBig(b, func(z, x *big.Int, n int) { z.AndNot(x, &big256Samples[n]) })
+	})
+	b.Run("RotateLeft/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { z.RotateLeft(x, n) })
+	})
+	b.Run("ReverseBits/uint256", func(b *testing.B) {
+		benchmarkUint256(b, func(z, x *Int, n uint) { z.ReverseBits(x) })
+	})
+}
+
 func benchmark_Cmp_Big(bench *testing.B) {
 	b1 := big.NewInt(0).SetBytes(hex2Bytes("0123456789abcdeffedcba9876543210f2f3f4f5f6f7f8f9fff3f4f5f6f7f8f9"))
 	b2 := big.NewInt(0).SetBytes(hex2Bytes("0123456789abcdefaaaaaa9876543210f2f3f4f5f6f7f8f9fff3f4f5f6f7f8f9"))
@@ -616,22 +674,46 @@ func benchmark_Exp_Big(bench *testing.B, x, y string) {
 }
 
 func benchmark_Exp_U256(bench *testing.B, x, y string) {
+	benchmark_Exp_U256_Fn(bench, x, y, (*Int).Exp)
+}
+
+func benchmark_Exp_U256_Fn(bench *testing.B, x, y string, exp func(z, base, exponent *Int) *Int) {
 	var (
 		base = big.NewInt(0).SetBytes(hex2Bytes(x))
-		exp  = big.NewInt(0).SetBytes(hex2Bytes(y))
+		expo = big.NewInt(0).SetBytes(hex2Bytes(y))
 
 		f_base, _ = FromBig(base)
 		f_orig, _ = FromBig(base)
-		f_exp, _  = FromBig(exp)
+		f_exp, _  = FromBig(expo)
 		f_res     Int
 	)
 	bench.ResetTimer()
 	for i := 0; i < bench.N; i++ {
-		f_res.Exp(f_base, f_exp)
+		exp(&f_res, f_base, f_exp)
 		f_base.Set(f_orig)
 	}
 }
 
+// expSquareMultiply is plain right-to-left square-and-multiply over every bit
+// of the exponent, as Exp did before the sliding window. It is the baseline
+// of BenchmarkExp.
+func expSquareMultiply(z, base, exponent *Int) *Int {
+	var (
+		res        = Int{1, 0, 0, 0}
+		multiplier = *base
+	)
+	if base[0]&1 == 0 && exponent.BitLen() > 8 {
+		return z.Clear()
+	}
+	for i, n := 0, exponent.BitLen(); i < n; i++ {
+		if exponent.Bit(uint(i)) == 1 {
+			res.Mul(&res, &multiplier)
+		}
+		multiplier.squared()
+	}
+	return z.Set(&res)
+}
+
 func BenchmarkExp(bench *testing.B) {
 	{ // Large values
 		base := "ABCDEF090807060504030201ffffffffffffffffffffffffffffffffffffffff"
@@ -642,6 +724,22 @@ func BenchmarkExp(bench *testing.B) {
 		bench.Run("large/uint256", func(b *testing.B) {
 			benchmark_Exp_U256(b, base, exp)
 		})
+		bench.Run("large/square-multiply", func(b *testing.B) {
+			benchmark_Exp_U256_Fn(b, base, exp, expSquareMultiply)
+		})
+	}
+	{ // Medium exponent
+		base := "ABCDEF090807060504030201ffffffffffffffffffffffffffffffffffffffff"
+		exp := "ABCDEF090807060504030201ffffffffffff"
+		bench.Run("medium/big", func(b *testing.B) {
+			benchmark_Exp_Big(b, base, exp)
+		})
+		bench.Run("medium/uint256", func(b *testing.B) {
+			benchmark_Exp_U256(b, base, exp)
+		})
+		bench.Run("medium/square-multiply", func(b *testing.B) {
+			benchmark_Exp_U256_Fn(b, base, exp, expSquareMultiply)
+		})
 	}
 	{ // Smaller exponent
 		base := "ABCDEF090807060504030201ffffffffffffffffffffffffffffffffffffffff"
@@ -663,6 +761,78 @@ func BenchmarkExp(bench *testing.B) {
 			benchmark_Exp_U256(b, base, exp)
 		})
 	}
+	{ // Power of two base
+		base := "10"
+		exp := "3f"
+		bench.Run("pow2/big", func(b *testing.B) {
+			benchmark_Exp_Big(b, base, exp)
+		})
+		bench.Run("pow2/uint256", func(b *testing.B) {
+			benchmark_Exp_U256(b, base, exp)
+		})
+	}
+}
+
+func BenchmarkExpOverflow(b *testing.B) {
+	var (
+		ten  = NewInt(10)
+		exp  = NewInt(77) // the largest power of ten below 2**256
+		sink Int
+	)
+	for i := 0; i < b.N; i++ {
+		sink.ExpOverflow(ten, exp)
+	}
+}
+
+func BenchmarkExpMod(b *testing.B) {
+	var (
+		// secp256k1 field prime, and a full 256-bit exponent
+		m   = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+		exp = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d")
+	)
+	benchmarkExpModUint256 := func(b *testing.B, mod *Int) {
+		var sink Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				sink.ExpMod(&int256Samples[i], exp, mod)
+			}
+		}
+	}
+	benchmarkExpModBig := func(b *testing.B, mod *Int) {
+		var (
+			sink       big.Int
+			bmod, bexp = mod.ToBig(), exp.ToBig()
+		)
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				sink.Exp(&big256Samples[i], bexp, bmod)
+			}
+		}
+	}
+	b.Run("mod256/uint256", func(b *testing.B) { benchmarkExpModUint256(b, m) })
+	b.Run("mod64/uint256", func(b *testing.B) { benchmarkExpModUint256(b, &int64Samples[0]) })
+	b.Run("mod256/big", func(b *testing.B) { benchmarkExpModBig(b, m) })
+	b.Run("mod64/big", func(b *testing.B) { benchmarkExpModBig(b, &int64Samples[0]) })
+}
+
+func BenchmarkCTExpMod(b *testing.B) {
+	var (
+		m    = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+		exp  = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d")
+		f, _ = NewMontgomery(m)
+		base = MustFromHex("0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+		sink Int
+	)
+	b.Run("ct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink.CTExpMod(base, exp, f)
+		}
+	})
+	b.Run("vartime", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink.ExpMod(base, exp, m)
+		}
+	})
 }
 
 func BenchmarkDiv(b *testing.B) {
@@ -695,6 +865,50 @@ func BenchmarkDiv(b *testing.B) {
 	b.Run("mod256/big", func(b *testing.B) { benchmarkDivBig(b, &big256Samples, &big256SamplesLt) })
 }
 
+func BenchmarkDivisor(b *testing.B) {
+	benchmarkDivUint256 := func(b *testing.B, xSamples, modSamples *[numSamples]Int) {
+		var sink Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				sink.Div(&xSamples[i], &modSamples[0])
+			}
+		}
+	}
+	benchmarkDivisorDiv := func(b *testing.B, xSamples, modSamples *[numSamples]Int) {
+		var (
+			sink Int
+			d    = NewDivisor(&modSamples[0])
+		)
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				d.Div(&sink, &xSamples[i])
+			}
+		}
+	}
+	benchmarkDivisorMod := func(b *testing.B, xSamples, modSamples *[numSamples]Int) {
+		var (
+			sink Int
+			d    = NewDivisor(&modSamples[0])
+		)
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				d.Mod(&sink, &xSamples[i])
+			}
+		}
+	}
+
+	b.Run("mod64/uint256", func(b *testing.B) { benchmarkDivUint256(b, &int256Samples, &int64Samples) })
+	b.Run("mod128/uint256", func(b *testing.B) { benchmarkDivUint256(b, &int256Samples, &int128Samples) })
+	b.Run("mod192/uint256", func(b *testing.B) { benchmarkDivUint256(b, &int256Samples, &int192Samples) })
+	b.Run("mod256/uint256", func(b *testing.B) { benchmarkDivUint256(b, &int256Samples, &int256SamplesLt) })
+	b.Run("mod64/divisor", func(b *testing.B) { benchmarkDivisorDiv(b, &int256Samples, &int64Samples) })
+	b.Run("mod128/divisor", func(b *testing.B) { benchmarkDivisorDiv(b, &int256Samples, &int128Samples) })
+	b.Run("mod192/divisor", func(b *testing.B) { benchmarkDivisorDiv(b, &int256Samples, &int192Samples) })
+	b.Run("mod256/divisor", func(b *testing.B) { benchmarkDivisorDiv(b, &int256Samples, &int256SamplesLt) })
+	b.Run("mod64/divisor-mod", func(b *testing.B) { benchmarkDivisorMod(b, &int256Samples, &int64Samples) })
+	b.Run("mod128/divisor-mod", func(b *testing.B) { benchmarkDivisorMod(b, &int256Samples, &int128Samples) })
+}
+
 func BenchmarkMod(b *testing.B) {
 	benchmarkModUint256 := func(b *testing.B, xSamples, modSamples *[numSamples]Int) {
 		var sink Int
@@ -843,6 +1057,9 @@ func BenchmarkMulMod(b *testing.B) {
 	b.Run("mod128/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int128SamplesLt, &int128Samples) })
 	b.Run("mod192/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int192SamplesLt, &int192Samples) })
 	b.Run("mod256/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int256SamplesLt, &int256Samples) })
+	b.Run("mod64/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int64SamplesLt, &int64Samples) })
+	b.Run("mod128/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int128SamplesLt, &int128Samples) })
+	b.Run("mod192/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int192SamplesLt, &int192Samples) })
 	b.Run("mod256/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int256SamplesLt, &int256Samples) })
 	b.Run("small/big", func(b *testing.B) { benchmarkMulModBig(b, &big32SamplesLt, &big32Samples) })
 	b.Run("mod64/big", func(b *testing.B) { benchmarkMulModBig(b, &big64SamplesLt, &big64Samples) })
@@ -851,6 +1068,34 @@ func BenchmarkMulMod(b *testing.B) {
 	b.Run("mod256/big", func(b *testing.B) { benchmarkMulModBig(b, &big256SamplesLt, &big256Samples) })
 }
 
+func BenchmarkMontgomeryMul(b *testing.B) {
+	// BN254 base field prime
+	m := MustFromHex("0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47")
+	f, _ := NewMontgomery(m)
+	mu := Reciprocal(m)
+
+	b.Run("montgomery", func(b *testing.B) {
+		var x, y Int
+		f.ToMont(&x, &int256Samples[0])
+		f.ToMont(&y, &int256Samples[1])
+		for i := 0; i < b.N; i++ {
+			f.Mul(&x, &x, &y)
+		}
+	})
+	b.Run("mulmod", func(b *testing.B) {
+		x, y := int256Samples[0], int256Samples[1]
+		for i := 0; i < b.N; i++ {
+			x.MulMod(&x, &y, m)
+		}
+	})
+	b.Run("mulmodr", func(b *testing.B) {
+		x, y := int256Samples[0], int256Samples[1]
+		for i := 0; i < b.N; i++ {
+			x.MulModWithReciprocal(&x, &y, m, &mu)
+		}
+	})
+}
+
 func benchmark_SdivLarge_Big(bench *testing.B) {
 	a := new(big.Int).SetBytes(hex2Bytes("800fffffffffffffffffffffffffd1e870eec79504c60144cc7f5fc2bad1e611"))
 	b := new(big.Int).SetBytes(hex2Bytes("ff3f9014f20db29ae04af2c2d265de17"))
@@ -970,6 +1215,39 @@ func BenchmarkMulDivOverflow(b *testing.B) {
 
 }
 
+func BenchmarkUint512DivMod(b *testing.B) {
+	benchmarkUint512 := func(b *testing.B, xSamples, modSamples *[numSamples]Int) {
+		var (
+			p   Uint512
+			rem Int
+		)
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				p.MulFull(&xSamples[i], &int256Samples[i])
+				p.DivMod(&p, &modSamples[i], &rem)
+			}
+		}
+	}
+	benchmarkBig := func(b *testing.B, xSamples, modSamples *[numSamples]big.Int) {
+		var p, rem big.Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				p.Mul(&xSamples[i], &big256Samples[i])
+				p.DivMod(&p, &modSamples[i], &rem)
+			}
+		}
+	}
+
+	b.Run("mod64/uint512", func(b *testing.B) { benchmarkUint512(b, &int256Samples, &int64Samples) })
+	b.Run("mod128/uint512", func(b *testing.B) { benchmarkUint512(b, &int256Samples, &int128Samples) })
+	b.Run("mod192/uint512", func(b *testing.B) { benchmarkUint512(b, &int256Samples, &int192Samples) })
+	b.Run("mod256/uint512", func(b *testing.B) { benchmarkUint512(b, &int256Samples, &int256SamplesLt) })
+	b.Run("mod64/big", func(b *testing.B) { benchmarkBig(b, &big256Samples, &big64Samples) })
+	b.Run("mod128/big", func(b *testing.B) { benchmarkBig(b, &big256Samples, &big128Samples) })
+	b.Run("mod192/big", func(b *testing.B) { benchmarkBig(b, &big256Samples, &big192Samples) })
+	b.Run("mod256/big", func(b *testing.B) { benchmarkBig(b, &big256Samples, &big256SamplesLt) })
+}
+
 func BenchmarkHashTreeRoot(b *testing.B) {
 	var (
 		z   = &Int{1, 2, 3, 4}
@@ -1138,3 +1416,102 @@ func BenchmarkSRsh(b *testing.B) {
 		_ = f2.SRsh(f2, 10)
 	}
 }
+
+func BenchmarkGCD(b *testing.B) {
+	benchmarkGCDUint256 := func(b *testing.B, xSamples, ySamples *[numSamples]Int) {
+		var sink Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				sink.GCD(&xSamples[i], &ySamples[i])
+			}
+		}
+	}
+	benchmarkGCDBig := func(b *testing.B, xSamples, ySamples *[numSamples]big.Int) {
+		var sink big.Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				sink.GCD(nil, nil, &xSamples[i], &ySamples[i])
+			}
+		}
+	}
+	b.Run("small/uint256", func(b *testing.B) { benchmarkGCDUint256(b, &int32Samples, &int32SamplesLt) })
+	b.Run("mod128/uint256", func(b *testing.B) { benchmarkGCDUint256(b, &int256Samples, &int128Samples) })
+	b.Run("mod256/uint256", func(b *testing.B) { benchmarkGCDUint256(b, &int256Samples, &int256SamplesLt) })
+	b.Run("small/big", func(b *testing.B) { benchmarkGCDBig(b, &big32Samples, &big32SamplesLt) })
+	b.Run("mod128/big", func(b *testing.B) { benchmarkGCDBig(b, &big256Samples, &big128Samples) })
+	b.Run("mod256/big", func(b *testing.B) { benchmarkGCDBig(b, &big256Samples, &big256SamplesLt) })
+}
+
+func BenchmarkModInverse(b *testing.B) {
+	var (
+		// secp256k1 field prime (odd), and an even modulus
+		oddMod  = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+		evenMod = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e")
+	)
+	benchmarkUint256 := func(b *testing.B, m *Int) {
+		var sink Int
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				sink.ModInverse(&int256Samples[i], m)
+			}
+		}
+	}
+	benchmarkBig := func(b *testing.B, m *Int) {
+		var (
+			sink big.Int
+			bm   = m.ToBig()
+		)
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				sink.ModInverse(&big256Samples[i], bm)
+			}
+		}
+	}
+	b.Run("odd/uint256", func(b *testing.B) { benchmarkUint256(b, oddMod) })
+	b.Run("even/uint256", func(b *testing.B) { benchmarkUint256(b, evenMod) })
+	b.Run("odd/big", func(b *testing.B) { benchmarkBig(b, oddMod) })
+	b.Run("even/big", func(b *testing.B) { benchmarkBig(b, evenMod) })
+}
+
+func BenchmarkBatchModInverse(b *testing.B) {
+	var (
+		m   = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+		mu  = Reciprocal(m)
+		dst = make([]Int, numSamples)
+	)
+	b.Run("batch", func(b *testing.B) {
+		for j := 0; j < b.N; j += numSamples {
+			BatchModInverse(dst, int256Samples[:], m, &mu)
+		}
+	})
+	b.Run("single", func(b *testing.B) {
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				dst[i].ModInverse(&int256Samples[i], m)
+			}
+		}
+	})
+}
+
+func BenchmarkModDotProduct(b *testing.B) {
+	var (
+		m    = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+		mu   = Reciprocal(m)
+		sink Int
+	)
+	b.Run("dot", func(b *testing.B) {
+		for j := 0; j < b.N; j += numSamples {
+			sink.ModDotProduct(int256Samples[:], int256SamplesLt[:], m, &mu)
+		}
+	})
+	b.Run("mulmod", func(b *testing.B) {
+		for j := 0; j < b.N; j += numSamples {
+			sink.Clear()
+			for i := 0; i < numSamples; i++ {
+				var p Int
+				p.MulModWithReciprocal(&int256Samples[i], &int256SamplesLt[i], m, &mu)
+				sink.AddMod(&sink, &p, m)
+			}
+		}
+	})
+}
@@ -63,11 +63,37 @@ var binaryOpFuncs = []binaryOpEntry{
 	{"And", (*Int).And, (*big.Int).And},
 	{"Or", (*Int).Or, (*big.Int).Or},
 	{"Xor", (*Int).Xor, (*big.Int).Xor},
+	{"AndNot", (*Int).AndNot, (*big.Int).AndNot},
+	{"Bit", func(z *Int, x *Int, y *Int) *Int {
+		return z.SetUint64(uint64(x.Bit(uint(y.Uint64() & 0x1FF))))
+	}, func(z, x, y *big.Int) *big.Int { return z.SetUint64(uint64(x.Bit(int(y.Uint64() & 0x1FF)))) }},
+	{"SetBit", func(z *Int, x *Int, y *Int) *Int {
+		return z.SetBit(x, uint(y.Uint64()&0x1FF), uint(y.Uint64()>>9))
+	}, func(z, x, y *big.Int) *big.Int { return z.SetBit(x, int(y.Uint64()&0x1FF), uint(y.Uint64()>>9)&1) }},
+	{"ClearBit", func(z *Int, x *Int, y *Int) *Int {
+		return z.ClearBit(x, uint(y.Uint64()&0x1FF))
+	}, func(z, x, y *big.Int) *big.Int { return z.SetBit(x, int(y.Uint64()&0x1FF), 0) }},
+	{"FlipBit", func(z *Int, x *Int, y *Int) *Int {
+		return z.FlipBit(x, uint(y.Uint64()&0x1FF))
+	}, func(z, x, y *big.Int) *big.Int {
+		n := int(y.Uint64() & 0x1FF)
+		return z.SetBit(x, n, x.Bit(n)^1)
+	}},
+	{"RotateLeft", func(z *Int, x *Int, y *Int) *Int {
+		return z.RotateLeft(x, uint(y.Uint64()&0x3FF))
+	}, func(z, x, y *big.Int) *big.Int { return bigRotateLeft(z, x, uint(y.Uint64()&0x3FF)%256) }},
+	{"RotateRight", func(z *Int, x *Int, y *Int) *Int {
+		return z.RotateRight(x, uint(y.Uint64()&0x3FF))
+	}, func(z, x, y *big.Int) *big.Int { return bigRotateLeft(z, x, (256-uint(y.Uint64()&0x3FF)%256)%256) }},
 	{"Exp", (*Int).Exp, func(b1, b2, b3 *big.Int) *big.Int { return b1.Exp(b2, b3, bigtt256) }},
 	{"IExp",
 		func(z *Int, x *Int, y *Int) *Int { return z.Set(x.Clone().IExp(y)) },
 		func(b1, b2, b3 *big.Int) *big.Int { return b1.Exp(b2, b3, bigtt256) },
 	},
+	{"ExpOverflow",
+		func(z *Int, x *Int, y *Int) *Int { r, _ := z.ExpOverflow(x, y); return r },
+		func(b1, b2, b3 *big.Int) *big.Int { return b1.Exp(b2, b3, bigtt256) },
+	},
 	{"Lsh", u256Lsh, bigLsh},
 	{"ILsh", func(z *Int, x *Int, y *Int) *Int {
 		return z.Set(x.Clone().ILsh(uint(y.Uint64() & 0x1FF)))
@@ -80,11 +106,67 @@ var binaryOpFuncs = []binaryOpEntry{
 	{"ISRsh", func(z *Int, x *Int, y *Int) *Int {
 		return z.Set(x.Clone().ISRsh(uint(y.Uint64() & 0x1FF)))
 	}, bigSRsh},
+	{"LshInt", (*Int).LshInt, bigLshInt},
+	{"ILshInt", func(z *Int, x *Int, y *Int) *Int {
+		return z.Set(x.Clone().ILshInt(y))
+	}, bigLshInt},
+	{"RshInt", (*Int).RshInt, bigRshInt},
+	{"IRshInt", func(z *Int, x *Int, y *Int) *Int {
+		return z.Set(x.Clone().IRshInt(y))
+	}, bigRshInt},
+	{"SRshInt", (*Int).SRshInt, bigSRshInt},
+	{"ISRshInt", func(z *Int, x *Int, y *Int) *Int {
+		return z.Set(x.Clone().ISRshInt(y))
+	}, bigSRshInt},
 	{"DivModDiv", divModDiv, bigDiv},
 	{"DivModMod", divModMod, bigMod},
 	{"udivremDiv", udivremDiv, bigDiv},
 	{"udivremMod", udivremMod, bigMod},
+	{"DivisorDiv", divisorDiv, bigDiv},
+	{"DivisorMod", divisorMod, bigMod},
+	{"DivisorDivModDiv", func(z *Int, x *Int, y *Int) *Int {
+		z, _ = NewDivisor(y).DivMod(z, new(Int), x)
+		return z
+	}, bigDiv},
+	{"DivisorDivModMod", func(z *Int, x *Int, y *Int) *Int {
+		_, z = NewDivisor(y).DivMod(new(Int), z, x)
+		return z
+	}, bigMod},
 	{"ExtendSign", (*Int).ExtendSign, bigExtendSign},
+	{"GCD", (*Int).GCD, bigGCD},
+	{"LCM", func(z *Int, x *Int, y *Int) *Int {
+		z, _ = z.LCM(x, y)
+		return z
+	}, bigLCM},
+	{"ModInverse", func(z *Int, x *Int, y *Int) *Int {
+		z, _ = z.ModInverse(x, y)
+		return z
+	}, bigModInverse},
+	{"AddSat", (*Int).AddSat, func(z, x, y *big.Int) *big.Int { return bigClampU256(z.Add(x, y)) }},
+	{"AddUint64Sat", func(z *Int, x *Int, y *Int) *Int {
+		return z.AddUint64Sat(x, y.Uint64())
+	}, func(z, x, y *big.Int) *big.Int { return bigClampU256(z.Add(x, new(big.Int).SetUint64(y.Uint64()))) }},
+	{"SubSat", (*Int).SubSat, func(z, x, y *big.Int) *big.Int { return bigClampU256(z.Sub(x, y)) }},
+	{"SubUint64Sat", func(z *Int, x *Int, y *Int) *Int {
+		return z.SubUint64Sat(x, y.Uint64())
+	}, func(z, x, y *big.Int) *big.Int { return bigClampU256(z.Sub(x, new(big.Int).SetUint64(y.Uint64()))) }},
+	{"MulSat", (*Int).MulSat, func(z, x, y *big.Int) *big.Int { return bigClampU256(z.Mul(x, y)) }},
+	{"LshSat", func(z *Int, x *Int, y *Int) *Int {
+		return z.LshSat(x, uint(y.Uint64()&0x1FF))
+	}, func(z, x, y *big.Int) *big.Int { return bigClampU256(bigLsh(z, x, y)) }},
+	{"ExpSat", (*Int).ExpSat, func(z, x, y *big.Int) *big.Int { return bigClampU256(bigExpCapped(z, x, y)) }},
+	{"SAddSat", (*Int).SAddSat, func(z, x, y *big.Int) *big.Int { return bigClampS256(z.Add(bigS256(x), bigS256(y))) }},
+	{"SSubSat", (*Int).SSubSat, func(z, x, y *big.Int) *big.Int { return bigClampS256(z.Sub(bigS256(x), bigS256(y))) }},
+	{"SMulSat", (*Int).SMulSat, func(z, x, y *big.Int) *big.Int { return bigClampS256(z.Mul(bigS256(x), bigS256(y))) }},
+	{"SLshSat", func(z *Int, x *Int, y *Int) *Int {
+		return z.SLshSat(x, uint(y.Uint64()&0x1FF))
+	}, func(z, x, y *big.Int) *big.Int { return bigClampS256(z.Lsh(bigS256(x), uint(y.Uint64()&0x1FF))) }},
+	{"DivRoundFloor", divRound(Floor), bigDivRound(Floor)},
+	{"DivRoundCeil", divRound(Ceil), bigDivRound(Ceil)},
+	{"DivRoundHalfUp", divRound(HalfUp), bigDivRound(HalfUp)},
+	{"DivRoundHalfEven", divRound(HalfEven), bigDivRound(HalfEven)},
+	{"DivRoundHalfDown", divRound(HalfDown), bigDivRound(HalfDown)},
+	{"SExpSat", (*Int).SExpSat, func(z, x, y *big.Int) *big.Int { return bigClampS256(bigExpCapped(z, bigS256(x), y)) }},
 }
 
 var cmpOpFuncs = []struct {
@@ -196,6 +278,32 @@ func bigSRsh(z, x, y *big.Int) *big.Int {
 	return z.Rsh(bigS256(x), uint(y.Uint64()&0x1FF))
 }
 
+// bigShiftAmount returns the shift count n, capped at 256
+func bigShiftAmount(n *big.Int) uint {
+	if n.Cmp(big.NewInt(256)) > 0 {
+		return 256
+	}
+	return uint(n.Uint64())
+}
+
+func bigLshInt(z, x, y *big.Int) *big.Int {
+	return z.Lsh(x, bigShiftAmount(y))
+}
+
+func bigRshInt(z, x, y *big.Int) *big.Int {
+	return z.Rsh(x, bigShiftAmount(y))
+}
+
+func bigSRshInt(z, x, y *big.Int) *big.Int {
+	return z.Rsh(bigS256(x), bigShiftAmount(y))
+}
+
+func bigRotateLeft(z, x *big.Int, n uint) *big.Int {
+	hi := new(big.Int).Lsh(x, n)
+	lo := new(big.Int).Rsh(x, 256-n)
+	return z.And(z.Or(hi, lo), bigtt256m1)
+}
+
 func bigExtendSign(result, num, byteNum *big.Int) *big.Int {
 	if byteNum.Cmp(big.NewInt(31)) >= 0 {
 		return result.Set(num)
@@ -263,6 +371,106 @@ func bigSMod(result, x, y *big.Int) *big.Int {
 	return bigU256(result)
 }
 
+// bigGCD implements GCD on big.Int, with GCD(x, 0) = x
+func bigGCD(z, x, y *big.Int) *big.Int {
+	return z.GCD(nil, nil, x, y)
+}
+
+// bigLCM implements LCM on big.Int, truncated to 256 bits: returns 0 if either argument is 0
+func bigLCM(z, x, y *big.Int) *big.Int {
+	if x.Sign() == 0 || y.Sign() == 0 {
+		return z.SetUint64(0)
+	}
+	g := new(big.Int).GCD(nil, nil, x, y)
+	z.Mul(new(big.Int).Div(x, g), y)
+	return bigU256(z)
+}
+
+// bigModInverse implements uint256 compatible ModInverse for big.Int: returns 0 when
+// there is no inverse or when m == 0
+func bigModInverse(z, x, m *big.Int) *big.Int {
+	if m.Sign() == 0 {
+		return z.SetUint64(0)
+	}
+	if z.ModInverse(x, m) == nil {
+		return z.SetUint64(0)
+	}
+	return z
+}
+
+// bigClampU256 clamps x to [0, 2^256-1]. This operation is destructive.
+func bigClampU256(x *big.Int) *big.Int {
+	switch {
+	case x.Sign() < 0:
+		return x.SetUint64(0)
+	case x.Cmp(bigtt256m1) > 0:
+		return x.Set(bigtt256m1)
+	}
+	return x
+}
+
+// bigClampS256 clamps x to [-2^255, 2^255-1]. This operation is destructive.
+func bigClampS256(x *big.Int) *big.Int {
+	switch {
+	case x.Cmp(bigMinInt256) < 0:
+		return x.Set(bigMinInt256)
+	case x.Cmp(bigMaxInt256) > 0:
+		return x.Set(bigMaxInt256)
+	}
+	return x
+}
+
+// bigExpCapped computes base**exponent on big.Int for clamping. Any base above
+// 1 in magnitude overflows with an exponent above 256, so for those it returns
+// an out of range value with the sign of the result instead.
+func bigExpCapped(z, base, exponent *big.Int) *big.Int {
+	if base.CmpAbs(big.NewInt(1)) > 0 && exponent.Cmp(big.NewInt(256)) > 0 {
+		if base.Sign() < 0 && exponent.Bit(0) == 1 {
+			return z.Sub(z.SetUint64(0), bigtt256)
+		}
+		return z.Set(bigtt256)
+	}
+	return z.Exp(base, exponent, nil)
+}
+
+// divRound wraps DivRound with the given rounding mode
+func divRound(mode RoundingMode) opDualArgFunc {
+	return func(z, x, y *Int) *Int {
+		return z.DivRound(x, y, mode)
+	}
+}
+
+// bigDivRound implements DivRound on big.Int: returns 0 when dividing by 0
+func bigDivRound(mode RoundingMode) bigDualArgFunc {
+	return func(z, x, y *big.Int) *big.Int {
+		if y.Sign() == 0 {
+			return z.SetUint64(0)
+		}
+		rem := new(big.Int)
+		z.QuoRem(x, y, rem)
+		if rem.Sign() == 0 {
+			return z
+		}
+		// Compare 2*rem against y to find the position relative to the halfway point
+		half := rem.Lsh(rem, 1).Cmp(y)
+		var up bool
+		switch mode {
+		case Ceil:
+			up = true
+		case HalfUp:
+			up = half >= 0
+		case HalfEven:
+			up = half > 0 || (half == 0 && z.Bit(0) == 1)
+		case HalfDown:
+			up = half > 0
+		}
+		if up {
+			z.Add(z, big.NewInt(1))
+		}
+		return z
+	}
+}
+
 // divModDiv wraps DivMod and returns quotient only
 func divModDiv(z, x, y *Int) *Int {
 	var m Int
@@ -270,6 +478,16 @@ func divModDiv(z, x, y *Int) *Int {
 	return z
 }
 
+// divisorDiv wraps Divisor.Div with a divisor built from y
+func divisorDiv(z, x, y *Int) *Int {
+	return NewDivisor(y).Div(z, x)
+}
+
+// divisorMod wraps Divisor.Mod with a divisor built from y
+func divisorMod(z, x, y *Int) *Int {
+	return NewDivisor(y).Mod(z, x)
+}
+
 // divModMod wraps DivMod and returns modulus only
 func divModMod(z, x, y *Int) *Int {
 	new(Int).DivMod(x, y, z)
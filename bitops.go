@@ -0,0 +1,109 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// LeadingZeros returns the number of leading zero bits in z; the result is
+// 256 for z == 0.
+func (z *Int) LeadingZeros() int {
+	return 256 - z.BitLen()
+}
+
+// TrailingZeros returns the number of trailing zero bits in z; the result is
+// 256 for z == 0.
+func (z *Int) TrailingZeros() int {
+	switch {
+	case z[0] != 0:
+		return bits.TrailingZeros64(z[0])
+	case z[1] != 0:
+		return 64 + bits.TrailingZeros64(z[1])
+	case z[2] != 0:
+		return 128 + bits.TrailingZeros64(z[2])
+	default:
+		return 192 + bits.TrailingZeros64(z[3])
+	}
+}
+
+// OnesCount returns the number of one bits ("population count") in z.
+func (z *Int) OnesCount() int {
+	return bits.OnesCount64(z[0]) + bits.OnesCount64(z[1]) +
+		bits.OnesCount64(z[2]) + bits.OnesCount64(z[3])
+}
+
+// Bit returns the value of the n'th bit of z, i.e. (z>>n)&1.
+// If n >= 256, the result is 0.
+func (z *Int) Bit(n uint) uint {
+	if n >= 256 {
+		return 0
+	}
+	return uint(z[n/64]>>(n%64)) & 1
+}
+
+// SetBit sets z to x, with the n'th bit of x set to b, and returns z.
+// Only the lowest bit of b is used. If n >= 256, z is set to x.
+func (z *Int) SetBit(x *Int, n uint, b uint) *Int {
+	if b&1 == 0 {
+		return z.ClearBit(x, n)
+	}
+	z.Set(x)
+	if n < 256 {
+		z[n/64] |= 1 << (n % 64)
+	}
+	return z
+}
+
+// ClearBit sets z to x, with the n'th bit of x cleared, and returns z.
+// If n >= 256, z is set to x.
+func (z *Int) ClearBit(x *Int, n uint) *Int {
+	z.Set(x)
+	if n < 256 {
+		z[n/64] &^= 1 << (n % 64)
+	}
+	return z
+}
+
+// FlipBit sets z to x, with the n'th bit of x inverted, and returns z.
+// If n >= 256, z is set to x.
+func (z *Int) FlipBit(x *Int, n uint) *Int {
+	z.Set(x)
+	if n < 256 {
+		z[n/64] ^= 1 << (n % 64)
+	}
+	return z
+}
+
+// AndNot sets z = x &^ y and returns z.
+func (z *Int) AndNot(x, y *Int) *Int {
+	z[0] = x[0] &^ y[0]
+	z[1] = x[1] &^ y[1]
+	z[2] = x[2] &^ y[2]
+	z[3] = x[3] &^ y[3]
+	return z
+}
+
+// RotateLeft sets z to x rotated left by (n mod 256) bits, and returns z.
+func (z *Int) RotateLeft(x *Int, n uint) *Int {
+	n %= 256
+	if n == 0 {
+		return z.Set(x)
+	}
+	var hi, lo Int
+	hi.Lsh(x, n)
+	lo.Rsh(x, 256-n)
+	return z.Or(&hi, &lo)
+}
+
+// RotateRight sets z to x rotated right by (n mod 256) bits, and returns z.
+func (z *Int) RotateRight(x *Int, n uint) *Int {
+	return z.RotateLeft(x, 256-n%256)
+}
+
+// ReverseBits sets z to x with its bits in reversed order, and returns z.
+func (z *Int) ReverseBits(x *Int) *Int {
+	z[0], z[3] = bits.Reverse64(x[3]), bits.Reverse64(x[0])
+	z[1], z[2] = bits.Reverse64(x[2]), bits.Reverse64(x[1])
+	return z
+}
@@ -0,0 +1,69 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "testing"
+
+func TestBitOpsLimits(t *testing.T) {
+	var (
+		zero = new(Int)
+		max  = new(Int).SetAllOne()
+		top  = new(Int).Lsh(NewInt(1), 255)
+	)
+	if have := zero.LeadingZeros(); have != 256 {
+		t.Errorf("LeadingZeros(0): have %d", have)
+	}
+	if have := zero.TrailingZeros(); have != 256 {
+		t.Errorf("TrailingZeros(0): have %d", have)
+	}
+	if have := top.TrailingZeros(); have != 255 {
+		t.Errorf("TrailingZeros(2^255): have %d", have)
+	}
+	if have := max.OnesCount(); have != 256 {
+		t.Errorf("OnesCount(2^256-1): have %d", have)
+	}
+	if have := max.Bit(256); have != 0 {
+		t.Errorf("Bit(2^256-1, 256): have %d", have)
+	}
+	if have := new(Int).SetBit(zero, 256, 1); !have.IsZero() {
+		t.Errorf("SetBit(0, 256, 1): have %v", have.Hex())
+	}
+	if have := new(Int).SetBit(zero, 255, 3); !have.Eq(top) {
+		t.Errorf("SetBit(0, 255, 3): have %v", have.Hex())
+	}
+	if have := new(Int).RotateLeft(top, 1); !have.Eq(NewInt(1)) {
+		t.Errorf("RotateLeft(2^255, 1): have %v", have.Hex())
+	}
+	if have := new(Int).RotateRight(NewInt(1), 1); !have.Eq(top) {
+		t.Errorf("RotateRight(1, 1): have %v", have.Hex())
+	}
+	if have := new(Int).RotateLeft(top, 256); !have.Eq(top) {
+		t.Errorf("RotateLeft(2^255, 256): have %v", have.Hex())
+	}
+	if have := new(Int).ReverseBits(NewInt(1)); !have.Eq(top) {
+		t.Errorf("ReverseBits(1): have %v", have.Hex())
+	}
+}
+
+func TestBitOpsAllocs(t *testing.T) {
+	var (
+		x = randNum()
+		y = randNum()
+		z Int
+	)
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = x.LeadingZeros() + x.TrailingZeros() + x.OnesCount() + int(x.Bit(7))
+		z.SetBit(x, 7, 1)
+		z.ClearBit(x, 7)
+		z.FlipBit(x, 7)
+		z.AndNot(x, y)
+		z.RotateLeft(x, 7)
+		z.RotateRight(x, 7)
+		z.ReverseBits(x)
+	})
+	if allocs != 0 {
+		t.Errorf("have %v allocations, want 0", allocs)
+	}
+}
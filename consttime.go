@@ -0,0 +1,157 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// The CT methods in this file run in constant time: their execution time and
+// memory access pattern do not depend on the values of the operands. They are
+// meant for secret values such as private keys and nonces, which the rest of
+// the API must not be used with.
+//
+// Moduli and Montgomery contexts are treated as public. Results of the
+// comparisons are 1 or 0, as in crypto/subtle, and should be consumed with
+// CTSelect or CTCondSwap; branching on them leaks them again.
+//
+// Go does not guarantee constant-time code generation. These methods are
+// built from bits.Add64, bits.Sub64 and bits.Mul64 and from masking, which
+// compile to branch-free instructions on the supported architectures, and
+// are checked with statistical timing tests
+// (UINT256_DUDECT=1 go test -run CTTiming).
+
+// ctMask returns all ones if cond == 1, and 0 if cond == 0
+func ctMask(cond int) uint64 {
+	return -uint64(cond)
+}
+
+// ctEqUint64 returns 1 if x == y, and 0 otherwise
+func ctEqUint64(x, y uint64) int {
+	v := x ^ y
+	// v | -v has the top bit set iff v != 0
+	return int(((v | -v) >> 63) ^ 1)
+}
+
+// CTEq returns 1 if z == x, and 0 otherwise, in constant time.
+func (z *Int) CTEq(x *Int) int {
+	return ctEqUint64((z[0]^x[0])|(z[1]^x[1])|(z[2]^x[2])|(z[3]^x[3]), 0)
+}
+
+// CTLt returns 1 if z < x, and 0 otherwise, in constant time.
+func (z *Int) CTLt(x *Int) int {
+	// z < x <=> z - x < 0 i.e. when subtraction overflows.
+	_, carry := bits.Sub64(z[0], x[0], 0)
+	_, carry = bits.Sub64(z[1], x[1], carry)
+	_, carry = bits.Sub64(z[2], x[2], carry)
+	_, carry = bits.Sub64(z[3], x[3], carry)
+	return int(carry)
+}
+
+// CTSelect sets z to a if cond == 1, or to b if cond == 0, and returns z, in
+// constant time. cond must be 0 or 1.
+func (z *Int) CTSelect(cond int, a, b *Int) *Int {
+	mask := ctMask(cond)
+	z[0] = b[0] ^ (mask & (a[0] ^ b[0]))
+	z[1] = b[1] ^ (mask & (a[1] ^ b[1]))
+	z[2] = b[2] ^ (mask & (a[2] ^ b[2]))
+	z[3] = b[3] ^ (mask & (a[3] ^ b[3]))
+	return z
+}
+
+// CTCondSwap swaps z and x if cond == 1, and leaves them unchanged if
+// cond == 0, in constant time. cond must be 0 or 1.
+func (z *Int) CTCondSwap(cond int, x *Int) {
+	mask := ctMask(cond)
+	for i := range z {
+		t := mask & (z[i] ^ x[i])
+		z[i] ^= t
+		x[i] ^= t
+	}
+}
+
+// CTAddMod sets z to x+y mod m, and returns z, in constant time. x and y must
+// be less than m.
+func (z *Int) CTAddMod(x, y, m *Int) *Int {
+	var (
+		s, t          Int
+		carry, borrow uint64
+	)
+	s[0], carry = bits.Add64(x[0], y[0], 0)
+	s[1], carry = bits.Add64(x[1], y[1], carry)
+	s[2], carry = bits.Add64(x[2], y[2], carry)
+	s[3], carry = bits.Add64(x[3], y[3], carry)
+	t[0], borrow = bits.Sub64(s[0], m[0], 0)
+	t[1], borrow = bits.Sub64(s[1], m[1], borrow)
+	t[2], borrow = bits.Sub64(s[2], m[2], borrow)
+	t[3], borrow = bits.Sub64(s[3], m[3], borrow)
+	// The sum is less than m iff it did not overflow, and subtracting m
+	// borrowed.
+	return z.CTSelect(int(borrow&^carry), &s, &t)
+}
+
+// CTSubMod sets z to x-y mod m, and returns z, in constant time. x and y must
+// be less than m.
+func (z *Int) CTSubMod(x, y, m *Int) *Int {
+	var (
+		d         Int
+		borrow, c uint64
+	)
+	d[0], borrow = bits.Sub64(x[0], y[0], 0)
+	d[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	d[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	d[3], borrow = bits.Sub64(x[3], y[3], borrow)
+	// Add m back if the subtraction borrowed
+	mask := ctMask(int(borrow))
+	z[0], c = bits.Add64(d[0], m[0]&mask, 0)
+	z[1], c = bits.Add64(d[1], m[1]&mask, c)
+	z[2], c = bits.Add64(d[2], m[2]&mask, c)
+	z[3], _ = bits.Add64(d[3], m[3]&mask, c)
+	return z
+}
+
+// CTMulMod sets z to x*y mod m, and returns z, in constant time, with m the
+// modulus of the Montgomery context f. x and y are regular values, not in
+// Montgomery form, and must be less than m.
+func (z *Int) CTMulMod(x, y *Int, f *Montgomery) *Int {
+	// (x*R^2*R^-1) * y * R^-1 = x*y
+	var t Int
+	f.Mul(&t, x, &f.r2)
+	return f.Mul(z, &t, y)
+}
+
+// ctWindow is the window width of CTExpMod, in bits
+const ctWindow = 4
+
+// CTExpMod sets z to base**exponent mod m, and returns z, in constant time,
+// with m the modulus of the Montgomery context f. base is a regular value, not
+// in Montgomery form, and must be less than m.
+//
+// It uses a fixed window of 4 bits over all 256 bits of the exponent: four
+// squarings and one multiplication per window, also for zero windows, with
+// the multiplier read from a table of 16 powers by selecting every entry.
+// With Montgomery multiplication it is faster than ExpMod for full-length
+// exponents, but it takes as long for short ones.
+func (z *Int) CTExpMod(base, exponent *Int, f *Montgomery) *Int {
+	var table [1 << ctWindow]Int
+	f.One(&table[0])
+	f.Mul(&table[1], base, &f.r2)
+	for i := 2; i < len(table); i++ {
+		f.Mul(&table[i], &table[i-1], &table[1])
+	}
+	res := table[0]
+	for i := 256/ctWindow - 1; i >= 0; i-- {
+		for j := 0; j < ctWindow; j++ {
+			f.Square(&res, &res)
+		}
+		var (
+			w   = (exponent[i*ctWindow/64] >> uint(i*ctWindow%64)) & (1<<ctWindow - 1)
+			sel Int
+		)
+		for k := range table {
+			sel.CTSelect(ctEqUint64(uint64(k), w), &table[k], &sel)
+		}
+		f.Mul(&res, &res, &sel)
+	}
+	return f.FromMont(z, &res)
+}
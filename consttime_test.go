@@ -0,0 +1,212 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+	"time"
+)
+
+// ctModuli are the moduli of the CT tests: CTAddMod and CTSubMod take any
+// modulus, the others odd ones.
+var ctModuli = append([]*Int{
+	NewInt(2),
+	MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000000"),
+}, montgomeryModuli...)
+
+// ctOperand returns a random value below m, with a bias to 0, 1 and m-1
+func ctOperand(m *Int) *Int {
+	switch rand.Intn(8) {
+	case 0:
+		return new(Int)
+	case 1:
+		return NewInt(1).Mod(NewInt(1), m)
+	case 2:
+		return new(Int).SubUint64(m, 1)
+	}
+	return randNum().Mod(randNum(), m)
+}
+
+func TestCTCompare(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		x, y := randNum(), randNum()
+		if i%4 == 0 {
+			y.Set(x)
+		}
+		if i%8 == 1 {
+			y[rand.Intn(4)] ^= 1 << uint(rand.Intn(64))
+		}
+		b2i := func(b bool) int {
+			if b {
+				return 1
+			}
+			return 0
+		}
+		if have, want := x.CTEq(y), b2i(x.Eq(y)); have != want {
+			t.Fatalf("CTEq(%v, %v) = %d, want %d", x.Hex(), y.Hex(), have, want)
+		}
+		if have, want := x.CTLt(y), b2i(x.Lt(y)); have != want {
+			t.Fatalf("CTLt(%v, %v) = %d, want %d", x.Hex(), y.Hex(), have, want)
+		}
+		if have, want := y.CTLt(x), b2i(y.Lt(x)); have != want {
+			t.Fatalf("CTLt(%v, %v) = %d, want %d", y.Hex(), x.Hex(), have, want)
+		}
+		a, b := x.Clone(), y.Clone()
+		if z := new(Int).CTSelect(1, x, y); !z.Eq(x) {
+			t.Fatalf("CTSelect(1) = %v, want %v", z.Hex(), x.Hex())
+		}
+		if z := new(Int).CTSelect(0, x, y); !z.Eq(y) {
+			t.Fatalf("CTSelect(0) = %v, want %v", z.Hex(), y.Hex())
+		}
+		if a.CTCondSwap(0, b); !a.Eq(x) || !b.Eq(y) {
+			t.Fatalf("CTCondSwap(0) swapped")
+		}
+		if a.CTCondSwap(1, b); !a.Eq(y) || !b.Eq(x) {
+			t.Fatalf("CTCondSwap(1) did not swap")
+		}
+	}
+}
+
+func TestCTModular(t *testing.T) {
+	for _, m := range ctModuli {
+		f, _ := NewMontgomery(m)
+		for i := 0; i < 1000; i++ {
+			x, y := ctOperand(m), ctOperand(m)
+			if have, want := new(Int).CTAddMod(x, y, m), new(Int).AddMod(x, y, m); !have.Eq(want) {
+				t.Fatalf("CTAddMod(%v, %v, %v) = %v, want %v", x.Hex(), y.Hex(), m.Hex(), have.Hex(), want.Hex())
+			}
+			if have, want := new(Int).CTSubMod(x, y, m), new(Int).SubMod(x, y, m); !have.Eq(want) {
+				t.Fatalf("CTSubMod(%v, %v, %v) = %v, want %v", x.Hex(), y.Hex(), m.Hex(), have.Hex(), want.Hex())
+			}
+			if f == nil {
+				continue
+			}
+			if have, want := new(Int).CTMulMod(x, y, f), new(Int).MulMod(x, y, m); !have.Eq(want) {
+				t.Fatalf("CTMulMod(%v, %v, %v) = %v, want %v", x.Hex(), y.Hex(), m.Hex(), have.Hex(), want.Hex())
+			}
+			e := randNum()
+			if i%16 == 0 {
+				e.Clear()
+			}
+			if have, want := new(Int).CTExpMod(x, e, f), new(Int).ExpMod(x, e, m); !have.Eq(want) {
+				t.Fatalf("CTExpMod(%v, %v, %v) = %v, want %v", x.Hex(), e.Hex(), m.Hex(), have.Hex(), want.Hex())
+			}
+			// Aliased results
+			if have, want := x.Clone().CTExpMod(x, e, f), new(Int).ExpMod(x, e, m); !have.Eq(want) {
+				t.Fatalf("aliased CTExpMod = %v, want %v", have.Hex(), want.Hex())
+			}
+			z := x.Clone()
+			if have, want := z.CTMulMod(z, z, f), new(Int).MulMod(x, x, m); !have.Eq(want) {
+				t.Fatalf("aliased CTMulMod = %v, want %v", have.Hex(), want.Hex())
+			}
+		}
+	}
+}
+
+// dudectThreshold is the |t| above which the timings of the two classes are
+// taken to differ. The dudect tool reports leaks from 4.5, and "definitely
+// not constant time" from 10; the higher bound keeps noisy machines quiet.
+const dudectThreshold = 10
+
+// dudectT runs the fixed-vs-random test of dudect: it measures op on inputs
+// of class 0 (fixed) and class 1 (random), interleaved at random, and returns
+// Welch's t statistic of the two timing distributions. The inputs of all
+// measurements are generated up front by input, and set by the same code for
+// both classes. The slowest 10% of the measurements are discarded, as they
+// are mostly interrupts and scheduling.
+func dudectT(measurements, batch int, input func(class int) [2]Int, set func([2]Int), op func()) float64 {
+	var (
+		warmup  = measurements / 10
+		classes = make([]int, warmup+measurements)
+		inputs  = make([][2]Int, len(classes))
+		times   = make([]float64, len(classes))
+	)
+	for i := range classes {
+		classes[i] = rand.Intn(2)
+		inputs[i] = input(classes[i])
+	}
+	for i := range classes {
+		set(inputs[i])
+		start := time.Now()
+		for j := 0; j < batch; j++ {
+			op()
+		}
+		times[i] = float64(time.Since(start))
+	}
+	classes, times = classes[warmup:], times[warmup:]
+	sorted := append([]float64{}, times...)
+	sort.Float64s(sorted)
+	cutoff := sorted[len(sorted)*9/10]
+
+	var n, mean, m2 [2]float64
+	for i, ns := range times {
+		if ns > cutoff {
+			continue
+		}
+		// Welford's online mean and variance
+		c := classes[i]
+		n[c]++
+		d := ns - mean[c]
+		mean[c] += d / n[c]
+		m2[c] += d * (ns - mean[c])
+	}
+	v0, v1 := m2[0]/(n[0]-1), m2[1]/(n[1]-1)
+	return (mean[0] - mean[1]) / math.Sqrt(v0/n[0]+v1/n[1])
+}
+
+var ctSink Int
+
+func TestCTTiming(t *testing.T) {
+	if os.Getenv("UINT256_DUDECT") == "" {
+		t.Skip("timing test, run with UINT256_DUDECT=1")
+	}
+	var (
+		m    = MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+		f, _ = NewMontgomery(m)
+		x, y Int
+		cond int
+	)
+	// Class 0 has the fixed inputs 0 and 0, class 1 random ones below m. The
+	// condition of CTSelect and CTCondSwap is the low bit of y.
+	input := func(class int) [2]Int {
+		if class == 0 {
+			return [2]Int{}
+		}
+		return [2]Int{*randNum().Mod(randNum(), m), *randNum().Mod(randNum(), m)}
+	}
+	set := func(in [2]Int) {
+		x, y = in[0], in[1]
+		cond = int(y[0] & 1)
+	}
+	for _, tc := range []struct {
+		name  string
+		n     int
+		batch int
+		op    func()
+		leaky bool
+	}{
+		{name: "CTEq", n: 100000, batch: 64, op: func() { cond = x.CTEq(&y) }},
+		{name: "CTLt", n: 100000, batch: 64, op: func() { cond = x.CTLt(&y) }},
+		{name: "CTSelect", n: 100000, batch: 64, op: func() { ctSink.CTSelect(cond, &x, &y) }},
+		{name: "CTCondSwap", n: 100000, batch: 64, op: func() { x.CTCondSwap(cond, &y) }},
+		{name: "CTAddMod", n: 100000, batch: 64, op: func() { ctSink.CTAddMod(&x, &y, m) }},
+		{name: "CTSubMod", n: 100000, batch: 64, op: func() { ctSink.CTSubMod(&x, &y, m) }},
+		{name: "CTMulMod", n: 100000, batch: 16, op: func() { ctSink.CTMulMod(&x, &y, f) }},
+		{name: "CTExpMod", n: 10000, batch: 1, op: func() { ctSink.CTExpMod(&x, &y, f) }},
+		// The harness must flag the variable-time counterparts.
+		{name: "MulMod", n: 100000, batch: 16, op: func() { ctSink.MulMod(&x, &y, m) }, leaky: true},
+		{name: "ExpMod", n: 10000, batch: 1, op: func() { ctSink.ExpMod(&x, &y, m) }, leaky: true},
+	} {
+		tv := dudectT(tc.n, tc.batch, input, set, tc.op)
+		t.Logf("%-10s t = %7.2f", tc.name, tv)
+		if leaks := math.Abs(tv) > dudectThreshold; leaks != tc.leaky {
+			t.Errorf("%s: |t| = %.2f, constant time: %v, want %v", tc.name, math.Abs(tv), !leaks, !tc.leaky)
+		}
+	}
+}
@@ -36,3 +36,70 @@ func udivrem2by1(uh, ul, d, reciprocal uint64) (quot, rem uint64) {
 
 	return qh, r
 }
+
+// reciprocal3by2 computes <^d, ^0, ^0> / d, for the normalized two-word
+// divisor d = <dh, dl>.
+// Implementation ported from https://github.com/chfast/intx and is based on
+// "Improved division by invariant integers", Algorithm 6.
+func reciprocal3by2(dh, dl uint64) uint64 {
+	reciprocal := reciprocal2by1(dh)
+	p := dh * reciprocal
+	p += dl
+	if p < dl {
+		reciprocal--
+		if p >= dh {
+			reciprocal--
+			p -= dh
+		}
+		p -= dh
+	}
+
+	th, tl := bits.Mul64(reciprocal, dl)
+	p += th
+	if p < th {
+		reciprocal--
+		if p >= dh {
+			if p > dh || tl >= dl {
+				reciprocal--
+			}
+		}
+	}
+	return reciprocal
+}
+
+// udivrem3by2 divides <u2, u1, u0> / <dh, dl> and produces both quotient and
+// remainder. It requires <u2, u1> < <dh, dl>, and uses the provided
+// reciprocal of the normalized divisor.
+// Implementation ported from https://github.com/chfast/intx and is based on
+// "Improved division by invariant integers", Algorithm 5.
+func udivrem3by2(u2, u1, u0, dh, dl, reciprocal uint64) (quot, remHi, remLo uint64) {
+	qh, ql := bits.Mul64(reciprocal, u2)
+	ql, carry := bits.Add64(ql, u1, 0)
+	qh, _ = bits.Add64(qh, u2, carry)
+
+	r1 := u1 - qh*dh
+
+	th, tl := bits.Mul64(dl, qh)
+
+	// <r1, r0> = <r1, u0> - <th, tl> - <dh, dl>
+	r0, borrow := bits.Sub64(u0, tl, 0)
+	r1, _ = bits.Sub64(r1, th, borrow)
+	r0, borrow = bits.Sub64(r0, dl, 0)
+	r1, _ = bits.Sub64(r1, dh, borrow)
+
+	qh++
+
+	if r1 >= ql {
+		qh--
+		r0, carry = bits.Add64(r0, dl, 0)
+		r1, _ = bits.Add64(r1, dh, carry)
+	}
+
+	if r1 > dh || (r1 == dh && r0 >= dl) {
+		qh++
+		r0, borrow = bits.Sub64(r0, dl, 0)
+		r1, _ = bits.Sub64(r1, dh, borrow)
+	}
+
+	return qh, r1, r0
+}
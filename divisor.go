@@ -0,0 +1,126 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// Divisor is a precomputed divisor, for repeated division by the same value.
+// It stores the divisor normalized for the division algorithm, along with the
+// reciprocal of its most significant word(s), which udivrem otherwise
+// recomputes on every call.
+type Divisor struct {
+	d          Int    // the divisor
+	dn         Int    // the divisor shifted left by shift bits
+	dLen       int    // the number of words in d
+	shift      uint   // the normalization shift
+	reciprocal uint64 // reciprocal3by2 of the top two words of dn if dLen == 2, else reciprocal2by1 of the top word
+}
+
+// NewDivisor returns a precomputed divisor for d.
+// If d == 0, the divisor results in 0 for all operations.
+func NewDivisor(d *Int) *Divisor {
+	v := &Divisor{d: *d}
+	for i := len(d) - 1; i >= 0; i-- {
+		if d[i] != 0 {
+			v.dLen = i + 1
+			break
+		}
+	}
+	if v.dLen == 0 {
+		return v
+	}
+	v.shift = uint(bits.LeadingZeros64(d[v.dLen-1]))
+	v.dn.Lsh(d, v.shift)
+	if v.dLen == 2 {
+		v.reciprocal = reciprocal3by2(v.dn[1], v.dn[0])
+	} else {
+		v.reciprocal = reciprocal2by1(v.dn[v.dLen-1])
+	}
+	return v
+}
+
+// Divisor returns the value of the divisor.
+func (v *Divisor) Divisor() *Int {
+	return v.d.Clone()
+}
+
+// Div sets z to the quotient x/d, and returns z.
+// If d == 0, z is set to 0
+func (v *Divisor) Div(z, x *Int) *Int {
+	var quot, rem Int
+	v.divrem(&quot, &rem, x)
+	return z.Set(&quot)
+}
+
+// Mod sets z to the modulus x%d, and returns z.
+// If d == 0, z is set to 0 (OBS: differs from the big.Int)
+func (v *Divisor) Mod(z, x *Int) *Int {
+	var quot, rem Int
+	v.divrem(&quot, &rem, x)
+	return z.Set(&rem)
+}
+
+// DivMod sets z to the quotient x/d and m to the modulus x%d, and returns
+// the pair (z, m).
+// If d == 0, both z and m are set to 0 (OBS: differs from the big.Int)
+func (v *Divisor) DivMod(z, m, x *Int) (*Int, *Int) {
+	var quot, rem Int
+	v.divrem(&quot, &rem, x)
+	z.Set(&quot)
+	m.Set(&rem)
+	return z, m
+}
+
+// divrem computes the quotient and the remainder of x divided by d.
+// quot and rem must be zero on entry.
+func (v *Divisor) divrem(quot, rem, x *Int) {
+	if v.dLen == 0 {
+		return
+	}
+	if x.Lt(&v.d) {
+		rem.Set(x)
+		return
+	}
+	var uLen int
+	for i := len(x) - 1; i >= 0; i-- {
+		if x[i] != 0 {
+			uLen = i + 1
+			break
+		}
+	}
+
+	// Normalize x with an extra top word
+	var (
+		shift = v.shift
+		un    [5]uint64
+	)
+	un[uLen] = x[uLen-1] >> (64 - shift)
+	for i := uLen - 1; i > 0; i-- {
+		un[i] = (x[i] << shift) | (x[i-1] >> (64 - shift))
+	}
+	un[0] = x[0] << shift
+
+	switch v.dLen {
+	case 1:
+		r := un[uLen]
+		for j := uLen - 1; j >= 0; j-- {
+			quot[j], r = udivrem2by1(r, un[j], v.dn[0], v.reciprocal)
+		}
+		rem[0] = r >> shift
+	case 2:
+		rh, rl := un[uLen], un[uLen-1]
+		for j := uLen - 2; j >= 0; j-- {
+			quot[j], rh, rl = udivrem3by2(rh, rl, un[j], v.dn[1], v.dn[0], v.reciprocal)
+		}
+		rem[0] = (rl >> shift) | (rh << (64 - shift))
+		rem[1] = rh >> shift
+	default:
+		udivremKnuth(quot[:], un[:uLen+1], v.dn[:v.dLen], v.reciprocal)
+		for i := 0; i < v.dLen-1; i++ {
+			rem[i] = (un[i] >> shift) | (un[i+1] << (64 - shift))
+		}
+		rem[v.dLen-1] = un[v.dLen-1] >> shift
+	}
+}
@@ -0,0 +1,370 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package ec implements point arithmetic on short Weierstrass curves
+// y² = x³ + ax + b over prime fields below 2^256, with the field arithmetic
+// of the fields package.
+//
+// Points are kept in affine or in Jacobian coordinates, where (X, Y, Z)
+// represents the affine point (X/Z², Y/Z³), and Z = 0 is the point at
+// infinity. The Curve methods may alias their results and arguments, and a
+// Curve can be used concurrently.
+//
+// The operations are not constant time, and must not be used with secret
+// scalars where timing can be observed.
+package ec
+
+import (
+	"errors"
+
+	"github.com/holiman/uint256"
+	"github.com/holiman/uint256/fields"
+)
+
+// Errors returned by Unmarshal
+var (
+	ErrInvalidEncoding = errors.New("invalid point encoding")
+	ErrNotOnCurve      = errors.New("point is not on the curve")
+)
+
+// Affine is a point in affine coordinates. The zero value is the point at
+// infinity only if Infinity is set; (0, 0) itself is not special.
+type Affine struct {
+	X, Y     uint256.Int
+	Infinity bool
+}
+
+// Jacobian is a point in Jacobian coordinates. The zero value is the point at
+// infinity.
+type Jacobian struct {
+	X, Y, Z uint256.Int
+}
+
+// IsInfinity reports whether p is the point at infinity.
+func (p *Jacobian) IsInfinity() bool {
+	return p.Z.IsZero()
+}
+
+// Curve is a short Weierstrass curve y² = x³ + ax + b over a prime field,
+// with a generator G of prime order n.
+type Curve struct {
+	name  string
+	f     *fields.Field
+	a, b  uint256.Int
+	g     Affine
+	n     uint256.Int
+	aZero bool // a == 0, which saves a multiplication in Double
+}
+
+var (
+	// Secp256k1 is the curve y² = x³ + 7 of SEC 2.
+	Secp256k1 = newCurve("secp256k1", fields.Secp256k1Base, fields.Secp256k1Scalar,
+		"0x0", "0x7",
+		"0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		"0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+
+	// P256 is the NIST P-256 (secp256r1) curve y² = x³ - 3x + b.
+	P256 = newCurve("P-256", fields.P256Base, fields.P256Scalar,
+		"0xffffffff00000001000000000000000000000000fffffffffffffffffffffffc",
+		"0x5ac635d8aa3a93e7b3ebbd55769886bc651d06b0cc53b0f63bce3c3e27d2604b",
+		"0x6b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296",
+		"0x4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5")
+
+	// BN254 is the G1 group of the BN254 (alt_bn128) pairing curve,
+	// y² = x³ + 3.
+	BN254 = newCurve("BN254", fields.BN254Base, fields.BN254Scalar,
+		"0x0", "0x3", "0x1", "0x2")
+)
+
+func newCurve(name string, f, scalar *fields.Field, a, b, gx, gy string) *Curve {
+	c := &Curve{
+		name: name,
+		f:    f,
+		a:    *uint256.MustFromHex(a),
+		b:    *uint256.MustFromHex(b),
+		g: Affine{
+			X: *uint256.MustFromHex(gx),
+			Y: *uint256.MustFromHex(gy),
+		},
+		n: *scalar.Modulus(),
+	}
+	c.aZero = c.a.IsZero()
+	return c
+}
+
+// Name returns the name of the curve.
+func (c *Curve) Name() string {
+	return c.name
+}
+
+// Field returns the field of the coordinates.
+func (c *Curve) Field() *fields.Field {
+	return c.f
+}
+
+// Order returns n, the order of the generator.
+func (c *Curve) Order() *uint256.Int {
+	return c.n.Clone()
+}
+
+// Generator returns the generator G.
+func (c *Curve) Generator() *Affine {
+	g := c.g
+	return &g
+}
+
+// IsOnCurve reports whether p is on the curve. The point at infinity is, and
+// coordinates which are not reduced modulo p are not.
+func (c *Curve) IsOnCurve(p *Affine) bool {
+	if p.Infinity {
+		return true
+	}
+	m := c.f.Modulus()
+	if !p.X.Lt(m) || !p.Y.Lt(m) {
+		return false
+	}
+	var lhs, rhs uint256.Int
+	c.f.Square(&lhs, &p.Y)
+	c.rhs(&rhs, &p.X)
+	return lhs.Eq(&rhs)
+}
+
+// rhs sets z to x³ + ax + b, and returns z.
+func (c *Curve) rhs(z, x *uint256.Int) *uint256.Int {
+	var t uint256.Int
+	c.f.Square(&t, x)
+	if !c.aZero {
+		c.f.Add(&t, &t, &c.a)
+	}
+	c.f.Mul(&t, &t, x)
+	return c.f.Add(z, &t, &c.b)
+}
+
+// ToJacobian sets z to p in Jacobian coordinates, and returns z.
+func (c *Curve) ToJacobian(z *Jacobian, p *Affine) *Jacobian {
+	if p.Infinity {
+		*z = Jacobian{}
+		return z
+	}
+	z.X, z.Y = p.X, p.Y
+	z.Z.SetOne()
+	return z
+}
+
+// ToAffine sets z to p in affine coordinates, and returns z.
+func (c *Curve) ToAffine(z *Affine, p *Jacobian) *Affine {
+	if p.IsInfinity() {
+		*z = Affine{Infinity: true}
+		return z
+	}
+	var zInv, zInv2 uint256.Int
+	c.f.Inverse(&zInv, &p.Z)
+	c.f.Square(&zInv2, &zInv)
+	c.f.Mul(&z.X, &p.X, &zInv2)
+	c.f.Mul(&zInv2, &zInv2, &zInv)
+	c.f.Mul(&z.Y, &p.Y, &zInv2)
+	z.Infinity = false
+	return z
+}
+
+// Neg sets z to -p, and returns z.
+func (c *Curve) Neg(z, p *Jacobian) *Jacobian {
+	z.X, z.Z = p.X, p.Z
+	c.f.Neg(&z.Y, &p.Y)
+	return z
+}
+
+// Double sets z to 2p, and returns z.
+func (c *Curve) Double(z, p *Jacobian) *Jacobian {
+	if p.IsInfinity() || p.Y.IsZero() {
+		*z = Jacobian{}
+		return z
+	}
+	f := c.f
+	var (
+		yy, s, m, t uint256.Int
+		x3, y3, z3  uint256.Int
+	)
+	// S = 4XY², M = 3X² + aZ⁴
+	f.Square(&yy, &p.Y)
+	f.Mul(&s, &p.X, &yy)
+	f.Add(&s, &s, &s)
+	f.Add(&s, &s, &s)
+	f.Square(&m, &p.X)
+	f.Add(&t, &m, &m)
+	f.Add(&m, &m, &t)
+	if !c.aZero {
+		f.Square(&t, &p.Z)
+		f.Square(&t, &t)
+		f.Mul(&t, &t, &c.a)
+		f.Add(&m, &m, &t)
+	}
+	// X3 = M² - 2S, Y3 = M(S - X3) - 8Y⁴, Z3 = 2YZ
+	f.Square(&x3, &m)
+	f.Sub(&x3, &x3, &s)
+	f.Sub(&x3, &x3, &s)
+	f.Sub(&t, &s, &x3)
+	f.Mul(&y3, &m, &t)
+	f.Square(&t, &yy)
+	f.Add(&t, &t, &t)
+	f.Add(&t, &t, &t)
+	f.Add(&t, &t, &t)
+	f.Sub(&y3, &y3, &t)
+	f.Mul(&z3, &p.Y, &p.Z)
+	f.Add(&z3, &z3, &z3)
+	z.X, z.Y, z.Z = x3, y3, z3
+	return z
+}
+
+// Add sets z to p + q, and returns z.
+func (c *Curve) Add(z, p, q *Jacobian) *Jacobian {
+	if p.IsInfinity() {
+		*z = *q
+		return z
+	}
+	if q.IsInfinity() {
+		*z = *p
+		return z
+	}
+	f := c.f
+	var (
+		z1z1, z2z2, u1, u2, s1, s2 uint256.Int
+		h, r, hh, hhh, v, t        uint256.Int
+		x3, y3, z3                 uint256.Int
+	)
+	// U1 = X1Z2², U2 = X2Z1², S1 = Y1Z2³, S2 = Y2Z1³
+	f.Square(&z1z1, &p.Z)
+	f.Square(&z2z2, &q.Z)
+	f.Mul(&u1, &p.X, &z2z2)
+	f.Mul(&u2, &q.X, &z1z1)
+	f.Mul(&s1, &p.Y, &z2z2)
+	f.Mul(&s1, &s1, &q.Z)
+	f.Mul(&s2, &q.Y, &z1z1)
+	f.Mul(&s2, &s2, &p.Z)
+	if u1.Eq(&u2) {
+		if s1.Eq(&s2) {
+			return c.Double(z, p)
+		}
+		// q = -p
+		*z = Jacobian{}
+		return z
+	}
+	// H = U2 - U1, R = S2 - S1
+	f.Sub(&h, &u2, &u1)
+	f.Sub(&r, &s2, &s1)
+	f.Square(&hh, &h)
+	f.Mul(&hhh, &hh, &h)
+	f.Mul(&v, &u1, &hh)
+	// X3 = R² - H³ - 2V, Y3 = R(V - X3) - S1H³, Z3 = HZ1Z2
+	f.Square(&x3, &r)
+	f.Sub(&x3, &x3, &hhh)
+	f.Sub(&x3, &x3, &v)
+	f.Sub(&x3, &x3, &v)
+	f.Sub(&t, &v, &x3)
+	f.Mul(&y3, &r, &t)
+	f.Mul(&t, &s1, &hhh)
+	f.Sub(&y3, &y3, &t)
+	f.Mul(&z3, &p.Z, &q.Z)
+	f.Mul(&z3, &z3, &h)
+	z.X, z.Y, z.Z = x3, y3, z3
+	return z
+}
+
+// scalarWindow is the window width of ScalarMult, in bits
+const scalarWindow = 4
+
+// ScalarMult sets z to k·p, and returns z. It uses a fixed window of 4 bits:
+// a table of 0·p to 15·p, and then four doublings and one addition for every
+// 4 bits of k.
+func (c *Curve) ScalarMult(z, p *Jacobian, k *uint256.Int) *Jacobian {
+	var table [1 << scalarWindow]Jacobian
+	table[1] = *p
+	for i := 2; i < len(table); i++ {
+		if i%2 == 0 {
+			c.Double(&table[i], &table[i/2])
+		} else {
+			c.Add(&table[i], &table[i-1], p)
+		}
+	}
+	var acc Jacobian
+	for i := (k.BitLen() + scalarWindow - 1) / scalarWindow * scalarWindow; i > 0; i -= scalarWindow {
+		for j := 0; j < scalarWindow; j++ {
+			c.Double(&acc, &acc)
+		}
+		w := (k[(i-scalarWindow)/64] >> uint((i-scalarWindow)%64)) & (1<<scalarWindow - 1)
+		if w != 0 {
+			c.Add(&acc, &acc, &table[w])
+		}
+	}
+	*z = acc
+	return z
+}
+
+// ScalarBaseMult sets z to k·G, and returns z.
+func (c *Curve) ScalarBaseMult(z *Jacobian, k *uint256.Int) *Jacobian {
+	var g Jacobian
+	return c.ScalarMult(z, c.ToJacobian(&g, &c.g), k)
+}
+
+// byteLen is the length of an encoded field element
+const byteLen = 32
+
+// Marshal returns the SEC 1 uncompressed encoding of p: 0x04 followed by the
+// 32-byte big-endian X and Y. The point at infinity is encoded as 0x00.
+func (c *Curve) Marshal(p *Affine) []byte {
+	if p.Infinity {
+		return []byte{0}
+	}
+	out := make([]byte, 1+2*byteLen)
+	out[0] = 4
+	p.X.PutUint256(out[1 : 1+byteLen])
+	p.Y.PutUint256(out[1+byteLen:])
+	return out
+}
+
+// MarshalCompressed returns the SEC 1 compressed encoding of p: 0x02 or 0x03
+// for an even or odd Y, followed by the 32-byte big-endian X. The point at
+// infinity is encoded as 0x00.
+func (c *Curve) MarshalCompressed(p *Affine) []byte {
+	if p.Infinity {
+		return []byte{0}
+	}
+	out := make([]byte, 1+byteLen)
+	out[0] = 2 | byte(p.Y[0]&1)
+	p.X.PutUint256(out[1:])
+	return out
+}
+
+// Unmarshal decodes a point in any of the SEC 1 encodings, and checks that it
+// is on the curve. It returns ErrInvalidEncoding for malformed input, and
+// ErrNotOnCurve if the coordinates are not a point of the curve.
+func (c *Curve) Unmarshal(data []byte) (*Affine, error) {
+	switch {
+	case len(data) == 1 && data[0] == 0:
+		return &Affine{Infinity: true}, nil
+	case len(data) == 1+2*byteLen && data[0] == 4:
+		p := new(Affine)
+		p.X.SetBytes(data[1 : 1+byteLen])
+		p.Y.SetBytes(data[1+byteLen:])
+		if !c.IsOnCurve(p) {
+			return nil, ErrNotOnCurve
+		}
+		return p, nil
+	case len(data) == 1+byteLen && (data[0] == 2 || data[0] == 3):
+		p := new(Affine)
+		p.X.SetBytes(data[1:])
+		if !p.X.Lt(c.f.Modulus()) {
+			return nil, ErrNotOnCurve
+		}
+		var y2 uint256.Int
+		if _, ok := c.f.Sqrt(&p.Y, c.rhs(&y2, &p.X)); !ok {
+			return nil, ErrNotOnCurve
+		}
+		if byte(p.Y[0]&1) != data[0]&1 {
+			c.f.Neg(&p.Y, &p.Y)
+		}
+		return p, nil
+	}
+	return nil, ErrInvalidEncoding
+}
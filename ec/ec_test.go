@@ -0,0 +1,295 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ec
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/holiman/uint256"
+)
+
+var curves = []*Curve{Secp256k1, P256, BN254}
+
+// bigPoint is an affine reference point, nil being the point at infinity
+type bigPoint struct{ x, y *big.Int }
+
+func toBig(p *Affine) *bigPoint {
+	if p.Infinity {
+		return nil
+	}
+	return &bigPoint{p.X.ToBig(), p.Y.ToBig()}
+}
+
+func bigAdd(c *Curve, p, q *bigPoint) *bigPoint {
+	if p == nil {
+		return q
+	}
+	if q == nil {
+		return p
+	}
+	mod := c.f.Modulus().ToBig()
+	l := new(big.Int)
+	if p.x.Cmp(q.x) == 0 {
+		if new(big.Int).Add(p.y, q.y).Mod(new(big.Int).Add(p.y, q.y), mod).Sign() == 0 {
+			return nil
+		}
+		// λ = (3x² + a) / 2y
+		l.Mul(p.x, p.x).Mul(l, big.NewInt(3)).Add(l, c.a.ToBig())
+		l.Mul(l, new(big.Int).ModInverse(new(big.Int).Lsh(p.y, 1), mod))
+	} else {
+		// λ = (y2 - y1) / (x2 - x1)
+		l.Sub(q.y, p.y)
+		l.Mul(l, new(big.Int).ModInverse(new(big.Int).Sub(q.x, p.x).Mod(new(big.Int).Sub(q.x, p.x), mod), mod))
+	}
+	l.Mod(l, mod)
+	x := new(big.Int).Mul(l, l)
+	x.Sub(x, p.x).Sub(x, q.x).Mod(x, mod)
+	y := new(big.Int).Sub(p.x, x)
+	y.Mul(y, l).Sub(y, p.y).Mod(y, mod)
+	return &bigPoint{x, y}
+}
+
+func bigScalarMult(c *Curve, p *bigPoint, k *big.Int) *bigPoint {
+	var acc *bigPoint
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		acc = bigAdd(c, acc, acc)
+		if k.Bit(i) == 1 {
+			acc = bigAdd(c, acc, p)
+		}
+	}
+	return acc
+}
+
+func eqBig(p *Affine, q *bigPoint) bool {
+	if q == nil {
+		return p.Infinity
+	}
+	return !p.Infinity && p.X.ToBig().Cmp(q.x) == 0 && p.Y.ToBig().Cmp(q.y) == 0
+}
+
+func randScalar(rnd *rand.Rand) *uint256.Int {
+	var k uint256.Int
+	for i := range k {
+		k[i] = rnd.Uint64()
+	}
+	// Vary the length, to hit short scalars and partial windows.
+	return k.Rsh(&k, uint(rnd.Intn(256)))
+}
+
+func affine(c *Curve, p *Jacobian) *Affine {
+	return c.ToAffine(new(Affine), p)
+}
+
+func TestGenerator(t *testing.T) {
+	for _, c := range curves {
+		g := c.Generator()
+		if !c.IsOnCurve(g) {
+			t.Errorf("%s: generator not on curve", c.Name())
+		}
+		var p Jacobian
+		if !c.ScalarBaseMult(&p, c.Order()).IsInfinity() {
+			t.Errorf("%s: n·G != ∞", c.Name())
+		}
+		// (n-1)·G = -G
+		nm1 := new(uint256.Int).SubUint64(c.Order(), 1)
+		c.ScalarBaseMult(&p, nm1)
+		var want Jacobian
+		c.Neg(&want, c.ToJacobian(&want, g))
+		if got, exp := affine(c, &p), affine(c, &want); *got != *exp {
+			t.Errorf("%s: (n-1)·G = %v, want %v", c.Name(), got, exp)
+		}
+		if !c.ScalarBaseMult(&p, new(uint256.Int)).IsInfinity() {
+			t.Errorf("%s: 0·G != ∞", c.Name())
+		}
+	}
+}
+
+func TestVectors(t *testing.T) {
+	for i, tc := range []struct {
+		c    *Curve
+		k    uint64
+		x, y string
+	}{
+		{P256, 2,
+			"0x7cf27b188d034f7e8a52380304b51ac3c08969e277f21b35a60b48fc47669978",
+			"0x7775510db8ed040293d9ac69f7430dbba7dade63ce982299e04b79d227873d1"},
+		{Secp256k1, 2,
+			"0xc6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+			"0x1ae168fea63dc339a3c58419466ceaeef7f632653266d0e1236431a950cfe52a"},
+		{Secp256k1, 3,
+			"0xf9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+			"0x388f7b0f632de8140fe337e62a37f3566500a99934c2231b6cb9fd7584b8e672"},
+	} {
+		var p Jacobian
+		got := affine(tc.c, tc.c.ScalarBaseMult(&p, uint256.NewInt(tc.k)))
+		want := Affine{X: *uint256.MustFromHex(tc.x), Y: *uint256.MustFromHex(tc.y)}
+		if *got != want {
+			t.Errorf("test %d: %s %d·G = %v, want %v", i, tc.c.Name(), tc.k, got, want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, c := range curves {
+		gBig := toBig(c.Generator())
+		for i := 0; i < 20; i++ {
+			k1, k2 := randScalar(rnd), randScalar(rnd)
+			var p, q, r Jacobian
+			c.ScalarBaseMult(&p, k1)
+			c.ScalarBaseMult(&q, k2)
+			pBig := bigScalarMult(c, gBig, k1.ToBig())
+			qBig := bigScalarMult(c, gBig, k2.ToBig())
+			if a := affine(c, &p); !eqBig(a, pBig) || !c.IsOnCurve(a) {
+				t.Fatalf("%s: ScalarBaseMult(%v) = %v", c.Name(), k1, a)
+			}
+			if a := affine(c, c.Add(&r, &p, &q)); !eqBig(a, bigAdd(c, pBig, qBig)) {
+				t.Fatalf("%s: Add = %v", c.Name(), a)
+			}
+			if a := affine(c, c.Double(&r, &p)); !eqBig(a, bigAdd(c, pBig, pBig)) {
+				t.Fatalf("%s: Double = %v", c.Name(), a)
+			}
+			// Add of a point to itself, and to its negation
+			if a := affine(c, c.Add(&r, &p, &p)); !eqBig(a, bigAdd(c, pBig, pBig)) {
+				t.Fatalf("%s: Add(p, p) = %v", c.Name(), a)
+			}
+			if !c.Add(&r, &p, c.Neg(&r, &p)).IsInfinity() {
+				t.Fatalf("%s: p + -p != ∞", c.Name())
+			}
+			// Scalar multiplication of a point with Z != 1
+			if a := affine(c, c.ScalarMult(&r, &p, k2)); !eqBig(a, bigScalarMult(c, pBig, k2.ToBig())) {
+				t.Fatalf("%s: ScalarMult = %v", c.Name(), a)
+			}
+			// Aliased results
+			want := affine(c, c.Add(&r, &p, &q))
+			if a := affine(c, c.Add(&p, &p, &q)); *a != *want {
+				t.Fatalf("%s: aliased Add = %v, want %v", c.Name(), a, want)
+			}
+			want = affine(c, c.ScalarMult(&r, &q, k1))
+			if a := affine(c, c.ScalarMult(&q, &q, k1)); *a != *want {
+				t.Fatalf("%s: aliased ScalarMult = %v, want %v", c.Name(), a, want)
+			}
+		}
+	}
+}
+
+func TestP256Elliptic(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	ref := elliptic.P256()
+	for i := 0; i < 50; i++ {
+		k1, k2 := randScalar(rnd), randScalar(rnd)
+		var p, q, r Jacobian
+		P256.ScalarBaseMult(&p, k1)
+		x1, y1 := ref.ScalarBaseMult(k1.Bytes())
+		pa := affine(P256, &p)
+		if pa.X.ToBig().Cmp(x1) != 0 || pa.Y.ToBig().Cmp(y1) != 0 {
+			t.Fatalf("ScalarBaseMult(%v) = %v, want (%x, %x)", k1, pa, x1, y1)
+		}
+		P256.ScalarMult(&q, &p, k2)
+		x2, y2 := ref.ScalarMult(x1, y1, k2.Bytes())
+		qa := affine(P256, &q)
+		if qa.X.ToBig().Cmp(x2) != 0 || qa.Y.ToBig().Cmp(y2) != 0 {
+			t.Fatalf("ScalarMult(%v) = %v, want (%x, %x)", k2, qa, x2, y2)
+		}
+		x3, y3 := ref.Add(x1, y1, x2, y2)
+		ra := affine(P256, P256.Add(&r, &p, &q))
+		if ra.X.ToBig().Cmp(x3) != 0 || ra.Y.ToBig().Cmp(y3) != 0 {
+			t.Fatalf("Add = %v, want (%x, %x)", ra, x3, y3)
+		}
+		x4, y4 := ref.Double(x1, y1)
+		ra = affine(P256, P256.Double(&r, &p))
+		if ra.X.ToBig().Cmp(x4) != 0 || ra.Y.ToBig().Cmp(y4) != 0 {
+			t.Fatalf("Double = %v, want (%x, %x)", ra, x4, y4)
+		}
+		// Encodings
+		if have, want := P256.Marshal(pa), elliptic.Marshal(ref, x1, y1); !bytes.Equal(have, want) {
+			t.Fatalf("Marshal = %x, want %x", have, want)
+		}
+		enc := elliptic.MarshalCompressed(ref, x1, y1)
+		if have := P256.MarshalCompressed(pa); !bytes.Equal(have, enc) {
+			t.Fatalf("MarshalCompressed = %x, want %x", have, enc)
+		}
+		dec, err := P256.Unmarshal(enc)
+		if err != nil || *dec != *pa {
+			t.Fatalf("Unmarshal(%x) = %v, %v, want %v", enc, dec, err, pa)
+		}
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for _, c := range curves {
+		for i := 0; i < 20; i++ {
+			var p Jacobian
+			a := affine(c, c.ScalarBaseMult(&p, randScalar(rnd)))
+			for _, enc := range [][]byte{c.Marshal(a), c.MarshalCompressed(a)} {
+				dec, err := c.Unmarshal(enc)
+				if err != nil || *dec != *a {
+					t.Fatalf("%s: Unmarshal(%x) = %v, %v, want %v", c.Name(), enc, dec, err, a)
+				}
+			}
+		}
+		inf := &Affine{Infinity: true}
+		if dec, err := c.Unmarshal(c.Marshal(inf)); err != nil || !dec.Infinity {
+			t.Errorf("%s: Unmarshal(∞) = %v, %v", c.Name(), dec, err)
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	g := P256.Marshal(P256.Generator())
+	gc := P256.MarshalCompressed(P256.Generator())
+	p := make([]byte, 32)
+	P256.Field().Modulus().PutUint256(p)
+
+	offCurve := append([]byte{}, g...)
+	offCurve[64] ^= 1
+	badPrefix := append([]byte{}, g...)
+	badPrefix[0] = 5
+	bigX := append([]byte{4}, p...)
+	bigX = append(bigX, g[33:]...)
+	// x = 1 gives y² = b - 2, which is not a square on P-256
+	noRoot := make([]byte, 33)
+	noRoot[0], noRoot[32] = 2, 1
+
+	for i, tc := range []struct {
+		data []byte
+		err  error
+	}{
+		{nil, ErrInvalidEncoding},
+		{[]byte{4}, ErrInvalidEncoding},
+		{g[:64], ErrInvalidEncoding},
+		{gc[:32], ErrInvalidEncoding},
+		{append(gc, 0), ErrInvalidEncoding},
+		{badPrefix, ErrInvalidEncoding},
+		{offCurve, ErrNotOnCurve},
+		{bigX, ErrNotOnCurve},
+		{append([]byte{2}, p...), ErrNotOnCurve},
+		{noRoot, ErrNotOnCurve},
+	} {
+		if _, err := P256.Unmarshal(tc.data); err != tc.err {
+			t.Errorf("test %d: Unmarshal(%x) err = %v, want %v", i, tc.data, err, tc.err)
+		}
+	}
+}
+
+func BenchmarkScalarMult(b *testing.B) {
+	k := uint256.MustFromHex("0xc51e4753afdec1e6b6c6a5b992f43f8dd0c7a8933072708b6522468b2ffb06fd")
+	for _, c := range curves {
+		b.Run(c.Name(), func(b *testing.B) {
+			var p Jacobian
+			c.ScalarBaseMult(&p, k)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c.ScalarMult(&p, &p, k)
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package fields provides ready-made contexts for the prime fields of common
+// elliptic curves: the base and scalar fields of secp256k1, P-256 (secp256r1)
+// and BN254, and the scalar field of BLS12-381.
+//
+// Each Field carries the precomputed constants for its modulus: the
+// reciprocal used by MulModWithReciprocal, the Montgomery constants, the
+// exponents for square roots and the Legendre symbol, and a generator of the
+// multiplicative group with its 2-adic root of unity.
+//
+// The arithmetic methods take and return field elements in the regular
+// representation, which must be less than the modulus. The results may alias
+// the arguments, and a Field can be used concurrently.
+package fields
+
+import "github.com/holiman/uint256"
+
+// Field is the context of a prime field GF(p), with p < 2^256.
+type Field struct {
+	name string
+	p    uint256.Int
+	mu   [5]uint64 // uint256.Reciprocal(p)
+	mont *uint256.Montgomery
+
+	// Montgomery constants, with R = 2^256
+	r    uint256.Int // R mod p
+	r2   uint256.Int // R^2 mod p
+	mInv uint64      // -p^-1 mod 2^64
+
+	// p-1 = q * 2^s, with q odd
+	s uint
+	q uint256.Int
+
+	sqrtExp     uint256.Int // (p+1)/4 if s == 1, otherwise (q+1)/2
+	legendreExp uint256.Int // (p-1)/2
+	generator   uint256.Int // the smallest generator of the multiplicative group
+	rootOfUnity uint256.Int // generator^q, a primitive 2^s-th root of unity
+}
+
+var (
+	// Secp256k1Base is the base field of secp256k1, p = 2^256 - 2^32 - 977.
+	Secp256k1Base = newField("secp256k1 base field",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		[5]uint64{0x1000003d1, 0, 0, 0, 0x1},
+		"0x1000003d1",
+		"0x1000007a2000e90a1",
+		0xd838091dd2253531,
+		1, 3,
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e")
+
+	// Secp256k1Scalar is the scalar field of secp256k1, of the group order n.
+	Secp256k1Scalar = newField("secp256k1 scalar field",
+		"0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+		[5]uint64{0x402da1732fc9bec0, 0x4551231950b75fc4, 0x1, 0, 0x1},
+		"0x14551231950b75fc4402da1732fc9bebf",
+		"0x9d671cd581c69bc5e697f5e45bcd07c6741496c20e7cf878896cf21467d7d140",
+		0x4b0dff665588b13f,
+		6, 7,
+		"0xc1dc060e7a91986df9879a3fbc483a898bdeab680756045992f4b5402b052f2")
+
+	// P256Base is the base field of P-256, p = 2^256 - 2^224 + 2^192 + 2^96 - 1.
+	P256Base = newField("P-256 base field",
+		"0xffffffff00000001000000000000000000000000ffffffffffffffffffffffff",
+		[5]uint64{0x3, 0xfffffffeffffffff, 0xfffffffefffffffe, 0xffffffff, 0x1},
+		"0xfffffffeffffffffffffffffffffffff000000000000000000000001",
+		"0x4fffffffdfffffffffffffffefffffffbffffffff0000000000000003",
+		0x1,
+		1, 6,
+		"0xffffffff00000001000000000000000000000000fffffffffffffffffffffffe")
+
+	// P256Scalar is the scalar field of P-256, of the group order n.
+	P256Scalar = newField("P-256 scalar field",
+		"0xffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551",
+		[5]uint64{0x12ffd85eedf9bfe, 0x43190552df1a6c21, 0xfffffffeffffffff, 0xffffffff, 0x1},
+		"0xffffffff00000000000000004319055258e8617b0c46353d039cdaaf",
+		"0x66e12d94f3d956202845b2392b6bec594699799c49bd6fa683244c95be79eea2",
+		0xccd1c8aaee00bc4f,
+		4, 7,
+		"0xffc97f062a770992ba807ace842a3dfc1546cad004378daf0592d7fbb41e6602")
+
+	// BN254Base is the base field of BN254 (alt_bn128).
+	BN254Base = newField("BN254 base field",
+		"0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47",
+		[5]uint64{0xf3aed8a19bf90e51, 0xe965e1767cd4c086, 0xb074a5868073013a, 0x4a47462623a04a7a, 0x5},
+		"0xe0a77c19a07df2f666ea36f7879462c0a78eb28f5c70b3dd35d438dc58f0d9d",
+		"0x6d89f71cab8351f47ab1eff0a417ff6b5e71911d44501fbf32cfc5b538afa89",
+		0x87d20782e4866389,
+		1, 3,
+		"0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd46")
+
+	// BN254Scalar is the scalar field of BN254 (alt_bn128).
+	BN254Scalar = newField("BN254 scalar field",
+		"0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001",
+		[5]uint64{0x20703a6be1de9259, 0x144852009e880ae6, 0xb074a58680730147, 0x4a47462623a04a7a, 0x5},
+		"0xe0a77c19a07df2f666ea36f7879462e36fc76959f60cd29ac96341c4ffffffb",
+		"0x216d0b17f4e44a58c49833d53bb808553fe3ab1e35c59e31bb8e645ae216da7",
+		0xc2e1f593efffffff,
+		28, 5,
+		"0x2a3c09f0a58a7e8500e0a7eb8ef62abc402d111e41112ed49bd61b6e725b19f0")
+
+	// BLS12381Scalar is the scalar field of BLS12-381.
+	BLS12381Scalar = newField("BLS12-381 scalar field",
+		"0x73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001",
+		[5]uint64{0x42737a020c0d6393, 0x65043eb4be4bad71, 0x38b5dcb707e08ed3, 0x355094edfede377c, 0x2},
+		"0x1824b159acc5056f998c4fefecbc4ff55884b7fa0003480200000001fffffffe",
+		"0x748d9d99f59ff1105d314967254398f2b6cedcb87925c23c999e990f3f29c6d",
+		0xfffffffeffffffff,
+		32, 7,
+		"0x16a2a19edfe81f20d09b681922c813b4b63683508c2280b93829971f439f0d2b")
+)
+
+// newField returns the context for the prime p, from its precomputed
+// constants. The exponents are derived from p.
+func newField(name, p string, mu [5]uint64, r, r2 string, mInv uint64, s uint, generator uint64, rootOfUnity string) *Field {
+	f := &Field{
+		name:        name,
+		p:           *uint256.MustFromHex(p),
+		mu:          mu,
+		r:           *uint256.MustFromHex(r),
+		r2:          *uint256.MustFromHex(r2),
+		mInv:        mInv,
+		s:           s,
+		generator:   *uint256.NewInt(generator),
+		rootOfUnity: *uint256.MustFromHex(rootOfUnity),
+	}
+	mont, err := uint256.NewMontgomery(&f.p)
+	if err != nil {
+		panic(err)
+	}
+	f.mont = mont
+	f.legendreExp.Rsh(&f.p, 1)
+	f.q.Rsh(&f.p, s)
+	if s == 1 {
+		// (p+1)/4 = ⌊p/4⌋ + 1, for p = 3 mod 4
+		f.sqrtExp.Rsh(&f.p, 2)
+	} else {
+		// (q+1)/2 = ⌊q/2⌋ + 1, for an odd q
+		f.sqrtExp.Rsh(&f.q, 1)
+	}
+	f.sqrtExp.AddUint64(&f.sqrtExp, 1)
+	return f
+}
+
+// Name returns the name of the field.
+func (f *Field) Name() string {
+	return f.name
+}
+
+// String returns the name of the field.
+func (f *Field) String() string {
+	return f.name
+}
+
+// Modulus returns the prime modulus p.
+func (f *Field) Modulus() *uint256.Int {
+	return f.p.Clone()
+}
+
+// Reciprocal returns the reciprocal of p, as computed by uint256.Reciprocal.
+func (f *Field) Reciprocal() [5]uint64 {
+	return f.mu
+}
+
+// Montgomery returns the Montgomery context for p. It is shared, and safe for
+// concurrent use.
+func (f *Field) Montgomery() *uint256.Montgomery {
+	return f.mont
+}
+
+// MontgomeryR returns R mod p, the Montgomery form of 1, with R = 2^256.
+func (f *Field) MontgomeryR() *uint256.Int {
+	return f.r.Clone()
+}
+
+// MontgomeryR2 returns R^2 mod p, which converts to Montgomery form.
+func (f *Field) MontgomeryR2() *uint256.Int {
+	return f.r2.Clone()
+}
+
+// MontgomeryNegInv returns -p^-1 mod 2^64.
+func (f *Field) MontgomeryNegInv() uint64 {
+	return f.mInv
+}
+
+// TwoAdicity returns s, the largest integer such that 2^s divides p-1.
+func (f *Field) TwoAdicity() uint {
+	return f.s
+}
+
+// OddPart returns q = (p-1) / 2^s, the odd part of p-1.
+func (f *Field) OddPart() *uint256.Int {
+	return f.q.Clone()
+}
+
+// Generator returns the smallest generator of the multiplicative group.
+func (f *Field) Generator() *uint256.Int {
+	return f.generator.Clone()
+}
+
+// RootOfUnity returns Generator()^q, a primitive 2^s-th root of unity.
+func (f *Field) RootOfUnity() *uint256.Int {
+	return f.rootOfUnity.Clone()
+}
+
+// SqrtExponent returns the exponent used by Sqrt: (p+1)/4 if p = 3 mod 4,
+// otherwise (q+1)/2 for Tonelli-Shanks.
+func (f *Field) SqrtExponent() *uint256.Int {
+	return f.sqrtExp.Clone()
+}
+
+// LegendreExponent returns (p-1)/2, the exponent of Euler's criterion.
+func (f *Field) LegendreExponent() *uint256.Int {
+	return f.legendreExp.Clone()
+}
+
+// Reduce sets z to x mod p, for any 256-bit x, and returns z.
+func (f *Field) Reduce(z, x *uint256.Int) *uint256.Int {
+	return z.Mod(x, &f.p)
+}
+
+// Add sets z to x+y mod p, and returns z.
+func (f *Field) Add(z, x, y *uint256.Int) *uint256.Int {
+	return z.AddMod(x, y, &f.p)
+}
+
+// Sub sets z to x-y mod p, and returns z.
+func (f *Field) Sub(z, x, y *uint256.Int) *uint256.Int {
+	return z.SubMod(x, y, &f.p)
+}
+
+// Neg sets z to -x mod p, and returns z.
+func (f *Field) Neg(z, x *uint256.Int) *uint256.Int {
+	return z.NegMod(x, &f.p)
+}
+
+// Mul sets z to x*y mod p, and returns z.
+func (f *Field) Mul(z, x, y *uint256.Int) *uint256.Int {
+	return z.MulModWithReciprocal(x, y, &f.p, &f.mu)
+}
+
+// Square sets z to x*x mod p, and returns z.
+func (f *Field) Square(z, x *uint256.Int) *uint256.Int {
+	return z.MulModWithReciprocal(x, x, &f.p, &f.mu)
+}
+
+// Exp sets z to x**exponent mod p, and returns z.
+func (f *Field) Exp(z, x, exponent *uint256.Int) *uint256.Int {
+	var t uint256.Int
+	f.mont.ToMont(&t, x)
+	f.mont.Exp(&t, &t, exponent)
+	return f.mont.FromMont(z, &t)
+}
+
+// Inverse sets z to x^-1 mod p, and returns z and true. If x == 0, z is set
+// to 0 and false is returned.
+func (f *Field) Inverse(z, x *uint256.Int) (*uint256.Int, bool) {
+	return z.ModInverse(x, &f.p)
+}
+
+// Legendre returns the Legendre symbol (x/p): 1 if x is a non-zero square,
+// -1 if it is not a square, and 0 if x == 0.
+func (f *Field) Legendre(x *uint256.Int) int {
+	var t uint256.Int
+	f.Exp(&t, x, &f.legendreExp)
+	switch {
+	case t.IsZero():
+		return 0
+	case t.Eq(&uint256.Int{1}):
+		return 1
+	default:
+		return -1
+	}
+}
+
+// Sqrt sets z to a square root of x, and returns z and true. If x is not a
+// square, z is set to 0 and false is returned.
+func (f *Field) Sqrt(z, x *uint256.Int) (*uint256.Int, bool) {
+	var r, sq uint256.Int
+	if f.s == 1 {
+		f.Exp(&r, x, &f.sqrtExp)
+	} else {
+		f.tonelliShanks(&r, x)
+	}
+	if !f.Square(&sq, &r).Eq(x) {
+		return z.Clear(), false
+	}
+	return z.Set(&r), true
+}
+
+// tonelliShanks sets z to a square root candidate of x, using the
+// precomputed root of unity. The result is only a root if x is a square.
+func (f *Field) tonelliShanks(z, x *uint256.Int) {
+	var (
+		one = &uint256.Int{1}
+		c   = f.rootOfUnity
+		t   uint256.Int
+		r   uint256.Int
+		m   = f.s
+	)
+	f.Exp(&t, x, &f.q)       // t = x^q
+	f.Exp(&r, x, &f.sqrtExp) // r = x^((q+1)/2)
+	for !t.IsZero() && !t.Eq(one) {
+		// The least i such that t^(2^i) = 1
+		var (
+			i  uint
+			t2 = t
+		)
+		for ; !t2.Eq(one); i++ {
+			f.Square(&t2, &t2)
+		}
+		if i == m {
+			// t has order 2^s, so x is not a square
+			z.Clear()
+			return
+		}
+		// b = c^(2^(m-i-1))
+		b := c
+		for j := uint(0); j < m-i-1; j++ {
+			f.Square(&b, &b)
+		}
+		m = i
+		f.Square(&c, &b)
+		f.Mul(&t, &t, &c)
+		f.Mul(&r, &r, &b)
+	}
+	z.Set(&r)
+}
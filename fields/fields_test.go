@@ -0,0 +1,241 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package fields
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+)
+
+var testFields = []struct {
+	f *Field
+	// Published constants: the modulus in decimal, the 2-adicity and the
+	// generator, and the odd prime factors of p-1
+	p          string
+	twoAdicity uint
+	generator  uint64
+	factors    []string
+}{
+	{Secp256k1Base,
+		"115792089237316195423570985008687907853269984665640564039457584007908834671663", 1, 3,
+		[]string{"3", "7", "13441", "205115282021455665897114700593932402728804164701536103180137503955397371"}},
+	{Secp256k1Scalar,
+		"115792089237316195423570985008687907852837564279074904382605163141518161494337", 6, 7,
+		[]string{"3", "149", "631", "107361793816595537", "174723607534414371449", "341948486974166000522343609283189"}},
+	{P256Base,
+		"115792089210356248762697446949407573530086143415290314195533631308867097853951", 1, 6,
+		[]string{"3", "5", "17", "257", "641", "1531", "65537", "490463", "6700417", "835945042244614951780389953367877943453916927241"}},
+	{P256Scalar,
+		"115792089210356248762697446949407573529996955224135760342422259061068512044369", 4, 7,
+		[]string{"3", "71", "131", "373", "3407", "17449", "38189", "187019741", "622491383", "1002328039319", "2624747550333869278416773953"}},
+	{BN254Base,
+		"21888242871839275222246405745257275088696311157297823662689037894645226208583", 1, 3,
+		[]string{"3", "13", "29", "67", "229", "311", "983", "11003", "405928799", "11465965001", "13427688667394608761327070753331941386769"}},
+	{BN254Scalar,
+		"21888242871839275222246405745257275088548364400416034343698204186575808495617", 28, 5,
+		[]string{"3", "13", "29", "983", "11003", "237073", "405928799", "1670836401704629", "13818364434197438864469338081"}},
+	{BLS12381Scalar,
+		"52435875175126190479447740508185965837690552500527637822603658699938581184513", 32, 7,
+		[]string{"3", "11", "19", "10177", "125527", "859267", "906349", "2508409", "2529403", "52437899", "254760293"}},
+}
+
+func TestPublishedConstants(t *testing.T) {
+	for _, tc := range testFields {
+		f := tc.f
+		if have := f.Modulus().Dec(); have != tc.p {
+			t.Errorf("%v: modulus %v, want %v", f, have, tc.p)
+		}
+		if f.TwoAdicity() != tc.twoAdicity {
+			t.Errorf("%v: 2-adicity %d, want %d", f, f.TwoAdicity(), tc.twoAdicity)
+		}
+		if !f.Generator().Eq(uint256.NewInt(tc.generator)) {
+			t.Errorf("%v: generator %v, want %d", f, f.Generator().Dec(), tc.generator)
+		}
+	}
+	// Roots of unity and Montgomery constants as published for BN254 and
+	// BLS12-381
+	for _, tc := range []struct {
+		have, want *uint256.Int
+	}{
+		{BN254Scalar.RootOfUnity(), uint256.MustFromDecimal("19103219067921713944291392827692070036145651957329286315305642004821462161904")},
+		{BLS12381Scalar.RootOfUnity(), uint256.MustFromHex("0x16a2a19edfe81f20d09b681922c813b4b63683508c2280b93829971f439f0d2b")},
+		{uint256.NewInt(BN254Scalar.MontgomeryNegInv()), uint256.NewInt(0xc2e1f593efffffff)},
+		{uint256.NewInt(BN254Base.MontgomeryNegInv()), uint256.NewInt(0x87d20782e4866389)},
+		{uint256.NewInt(BLS12381Scalar.MontgomeryNegInv()), uint256.NewInt(0xfffffffeffffffff)},
+		{uint256.NewInt(P256Base.MontgomeryNegInv()), uint256.NewInt(1)},
+	} {
+		if !tc.have.Eq(tc.want) {
+			t.Errorf("have %v, want %v", tc.have.Hex(), tc.want.Hex())
+		}
+	}
+}
+
+func TestPrecomputed(t *testing.T) {
+	var (
+		one      = big.NewInt(1)
+		two      = big.NewInt(2)
+		twoTo64  = new(big.Int).Lsh(one, 64)
+		twoTo256 = new(big.Int).Lsh(one, 256)
+	)
+	for _, tc := range testFields {
+		var (
+			f   = tc.f
+			p   = f.Modulus().ToBig()
+			pm1 = new(big.Int).Sub(p, one)
+		)
+		check := func(name string, have *uint256.Int, want *big.Int) {
+			t.Helper()
+			if !have.Eq(uint256.MustFromBig(want)) {
+				t.Errorf("%v: %s\nhave : %v\nwant : %#x", f, name, have.Hex(), want)
+			}
+		}
+		if !p.ProbablyPrime(20) {
+			t.Errorf("%v: modulus is not prime", f)
+		}
+		if mu := uint256.Reciprocal(f.Modulus()); mu != f.Reciprocal() {
+			t.Errorf("%v: reciprocal %x, want %x", f, f.Reciprocal(), mu)
+		}
+		r := new(big.Int).Mod(twoTo256, p)
+		check("R", f.MontgomeryR(), r)
+		check("R^2", f.MontgomeryR2(), new(big.Int).Mod(new(big.Int).Mul(r, r), p))
+		check("Montgomery one", f.Montgomery().One(new(uint256.Int)), r)
+		mInv := new(big.Int).ModInverse(p, twoTo64)
+		check("-p^-1", uint256.NewInt(f.MontgomeryNegInv()), mInv.Sub(twoTo64, mInv))
+
+		s := pm1.TrailingZeroBits()
+		q := new(big.Int).Rsh(pm1, s)
+		if f.TwoAdicity() != s {
+			t.Errorf("%v: 2-adicity %d, want %d", f, f.TwoAdicity(), s)
+		}
+		check("odd part", f.OddPart(), q)
+		check("Legendre exponent", f.LegendreExponent(), new(big.Int).Rsh(pm1, 1))
+		if s == 1 {
+			check("sqrt exponent", f.SqrtExponent(), new(big.Int).Rsh(new(big.Int).Add(p, one), 2))
+		} else {
+			check("sqrt exponent", f.SqrtExponent(), new(big.Int).Rsh(new(big.Int).Add(q, one), 1))
+		}
+
+		// The factorization of p-1 is complete, and the generator has full
+		// order: g^((p-1)/f) != 1 for every prime factor f
+		g := f.Generator().ToBig()
+		rest := new(big.Int).Rsh(pm1, s)
+		for _, fs := range tc.factors {
+			fac, _ := new(big.Int).SetString(fs, 10)
+			if !fac.ProbablyPrime(20) {
+				t.Errorf("%v: factor %v is not prime", f, fac)
+			}
+			for new(big.Int).Mod(rest, fac).Sign() == 0 {
+				rest.Div(rest, fac)
+			}
+			if new(big.Int).Exp(g, new(big.Int).Div(pm1, fac), p).Cmp(one) == 0 {
+				t.Errorf("%v: generator has order dividing (p-1)/%v", f, fac)
+			}
+		}
+		if rest.Cmp(one) != 0 {
+			t.Errorf("%v: incomplete factorization of p-1, %v left", f, rest)
+		}
+		if new(big.Int).Exp(g, new(big.Int).Rsh(pm1, 1), p).Cmp(one) == 0 {
+			t.Errorf("%v: generator is a square", f)
+		}
+		// The smallest generator: all smaller values fail one of the tests
+		for c := int64(2); c < int64(tc.generator); c++ {
+			bc := big.NewInt(c)
+			ok := new(big.Int).Exp(bc, new(big.Int).Rsh(pm1, 1), p).Cmp(one) != 0
+			for _, fs := range tc.factors {
+				fac, _ := new(big.Int).SetString(fs, 10)
+				ok = ok && new(big.Int).Exp(bc, new(big.Int).Div(pm1, fac), p).Cmp(one) != 0
+			}
+			if ok {
+				t.Errorf("%v: %d is a smaller generator", f, c)
+			}
+		}
+		// The root of unity has order exactly 2^s
+		root := f.RootOfUnity().ToBig()
+		check("root of unity", f.RootOfUnity(), new(big.Int).Exp(g, q, p))
+		if new(big.Int).Exp(root, new(big.Int).Lsh(one, s-1), p).Cmp(pm1) != 0 {
+			t.Errorf("%v: root^(2^(s-1)) != -1", f)
+		}
+		if new(big.Int).Exp(root, new(big.Int).Lsh(two, s-1), p).Cmp(one) != 0 {
+			t.Errorf("%v: root^(2^s) != 1", f)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	for _, tc := range testFields {
+		f := tc.f
+		p := f.Modulus().ToBig()
+		randElem := func() (*uint256.Int, *big.Int) {
+			b, _ := rand.Int(rand.Reader, p)
+			return uint256.MustFromBig(b), b
+		}
+		check := func(name string, x, y *uint256.Int, have *uint256.Int, want *big.Int) {
+			t.Helper()
+			if !have.Eq(uint256.MustFromBig(want)) {
+				t.Fatalf("%v: %s(%v, %v)\nhave : %v\nwant : %#x", f, name, x.Hex(), y.Hex(), have.Hex(), want)
+			}
+		}
+		for i := 0; i < 500; i++ {
+			x, bx := randElem()
+			y, by := randElem()
+			if i == 0 {
+				x.Clear()
+				bx.SetUint64(0)
+			}
+			z := new(uint256.Int)
+			check("Add", x, y, f.Add(z, x, y), new(big.Int).Mod(new(big.Int).Add(bx, by), p))
+			check("Sub", x, y, f.Sub(z, x, y), new(big.Int).Mod(new(big.Int).Sub(bx, by), p))
+			check("Neg", x, y, f.Neg(z, x), new(big.Int).Mod(new(big.Int).Neg(bx), p))
+			check("Mul", x, y, f.Mul(z, x, y), new(big.Int).Mod(new(big.Int).Mul(bx, by), p))
+			check("Square", x, y, f.Square(z, x), new(big.Int).Mod(new(big.Int).Mul(bx, bx), p))
+			check("Exp", x, y, f.Exp(z, x, y), new(big.Int).Exp(bx, by, p))
+			w := new(uint256.Int).Not(x)
+			check("Reduce", w, y, f.Reduce(z, w), new(big.Int).Mod(w.ToBig(), p))
+			// Arguments may alias the result
+			check("Mul aliased", x, y, f.Mul(z.Set(x), z, y), new(big.Int).Mod(new(big.Int).Mul(bx, by), p))
+
+			inv, ok := f.Inverse(z, x)
+			if want := new(big.Int).ModInverse(bx, p); ok != (want != nil) {
+				t.Fatalf("%v: Inverse(%v): ok %v", f, x.Hex(), ok)
+			} else if ok {
+				check("Inverse", x, y, inv, want)
+			}
+
+			if have, want := f.Legendre(x), big.Jacobi(bx, p); have != want {
+				t.Fatalf("%v: Legendre(%v): have %d, want %d", f, x.Hex(), have, want)
+			}
+			// Half of the elements are squares; and y^2 always is
+			for _, v := range []*uint256.Int{x, f.Square(new(uint256.Int), y)} {
+				root, ok := f.Sqrt(new(uint256.Int).SetAllOne(), v)
+				want := new(big.Int).ModSqrt(v.ToBig(), p)
+				if ok != (want != nil) {
+					t.Fatalf("%v: Sqrt(%v): ok %v, want %v", f, v.Hex(), ok, want)
+				}
+				if !ok {
+					if !root.IsZero() {
+						t.Fatalf("%v: Sqrt(%v) failed, but set %v", f, v.Hex(), root.Hex())
+					}
+					continue
+				}
+				if sq := f.Square(new(uint256.Int), root); !sq.Eq(v) {
+					t.Fatalf("%v: Sqrt(%v) = %v, squared %v", f, v.Hex(), root.Hex(), sq.Hex())
+				}
+			}
+		}
+		// Sqrt in place, and of the root of unity, which takes every round of
+		// Tonelli-Shanks
+		v := f.RootOfUnity()
+		if _, ok := f.Sqrt(v, v); ok {
+			t.Errorf("%v: primitive 2^s-th root of unity has a square root", f)
+		}
+		v = f.Square(new(uint256.Int), f.RootOfUnity())
+		if root, ok := f.Sqrt(new(uint256.Int), v); !ok || !f.Square(new(uint256.Int), root).Eq(v) {
+			t.Errorf("%v: Sqrt(root of unity^2) = %v, %v", f, root.Hex(), ok)
+		}
+	}
+}
@@ -0,0 +1,374 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "errors"
+
+const (
+	// WadDecimals is the number of decimals of a wad, the 18-decimal fixed
+	// point format used by most tokens.
+	WadDecimals = 18
+	// RayDecimals is the number of decimals of a ray, the 27-decimal fixed
+	// point format used for rates and other high precision values.
+	RayDecimals = 27
+	// MaxDecimals is the largest supported number of decimals. 10^77 is the
+	// largest power of ten which fits in 256 bits.
+	MaxDecimals = 77
+	// BasisPoints is the number of basis points in one, i.e. 100%.
+	BasisPoints = 10000
+)
+
+var (
+	ErrFixedSyntax    = errors.New("invalid fixed-point decimal string")
+	ErrFixedPrecision = errors.New("fixed-point decimal string has too many decimals")
+)
+
+// Fixed is an unsigned fixed-point decimal number. It holds the raw value
+// r and the number of decimals d, and represents r / 10^d.
+//
+// The zero value is 0 with 0 decimals. Operations which combine two values
+// document which number of decimals the result has. Rounding is never
+// implicit: every operation which can lose precision takes a RoundingMode.
+type Fixed struct {
+	raw      Int
+	decimals uint8
+}
+
+// pow10 returns 10^n. It panics if n > MaxDecimals.
+func pow10(n uint8) Int {
+	if n > MaxDecimals {
+		panic("uint256: fixed-point decimals out of range")
+	}
+	if n < 20 {
+		return Int{pows64[n]}
+	}
+	return pows[n-20]
+}
+
+// NewFixed returns a new Fixed with the given raw value and number of
+// decimals, i.e. raw / 10^decimals. It panics if decimals > MaxDecimals.
+func NewFixed(raw *Int, decimals uint8) *Fixed {
+	pow10(decimals) // check the range
+	return &Fixed{raw: *raw, decimals: decimals}
+}
+
+// NewWad returns a new Fixed with 18 decimals and the given raw value.
+func NewWad(raw *Int) *Fixed {
+	return NewFixed(raw, WadDecimals)
+}
+
+// NewRay returns a new Fixed with 27 decimals and the given raw value.
+func NewRay(raw *Int) *Fixed {
+	return NewFixed(raw, RayDecimals)
+}
+
+// Raw returns the raw value of z, i.e. z * 10^decimals.
+func (z *Fixed) Raw() *Int {
+	raw := z.raw
+	return &raw
+}
+
+// Decimals returns the number of decimals of z.
+func (z *Fixed) Decimals() uint8 {
+	return z.decimals
+}
+
+// Set sets z to x and returns z.
+func (z *Fixed) Set(x *Fixed) *Fixed {
+	*z = *x
+	return z
+}
+
+// SetInt sets z to the whole number x with the given number of decimals, and
+// returns z and whether overflow occurred. On overflow, the raw value of z is
+// x * 10^decimals mod 2^256.
+// It panics if decimals > MaxDecimals.
+func (z *Fixed) SetInt(x *Int, decimals uint8) (*Fixed, bool) {
+	scale := pow10(decimals)
+	_, overflow := z.raw.MulOverflow(x, &scale)
+	z.decimals = decimals
+	return z, overflow
+}
+
+// IsZero returns true if z == 0
+func (z *Fixed) IsZero() bool {
+	return z.raw.IsZero()
+}
+
+// Cmp compares z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+//
+// The values are compared exactly, also if their numbers of decimals differ.
+func (z *Fixed) Cmp(x *Fixed) int {
+	if z.decimals == x.decimals {
+		return z.raw.Cmp(&x.raw)
+	}
+	// Bring both to the larger number of decimals, in 512 bits.
+	var (
+		zScale = Int{1}
+		xScale = Int{1}
+		a, b   Uint512
+	)
+	if z.decimals < x.decimals {
+		zScale = pow10(x.decimals - z.decimals)
+	} else {
+		xScale = pow10(z.decimals - x.decimals)
+	}
+	a.MulFull(&z.raw, &zScale)
+	b.MulFull(&x.raw, &xScale)
+	return a.Cmp(&b)
+}
+
+// align returns the raw values of x and y, both brought to the larger of
+// their numbers of decimals, that number of decimals, and whether bringing
+// either value to it overflowed.
+func align(x, y *Fixed) (a, b Int, decimals uint8, overflow bool) {
+	a, b, decimals = x.raw, y.raw, x.decimals
+	if x.decimals < y.decimals {
+		scale := pow10(y.decimals - x.decimals)
+		_, overflow = a.MulOverflow(&a, &scale)
+		decimals = y.decimals
+	} else if x.decimals > y.decimals {
+		scale := pow10(x.decimals - y.decimals)
+		_, overflow = b.MulOverflow(&b, &scale)
+	}
+	return a, b, decimals, overflow
+}
+
+// Add sets z to the sum x+y, and returns z and whether overflow occurred.
+// The result has the larger of the numbers of decimals of x and y, so the sum
+// is exact unless it overflows. On overflow, the raw value of z is the exact
+// raw sum mod 2^256.
+func (z *Fixed) Add(x, y *Fixed) (*Fixed, bool) {
+	a, b, decimals, overflow := align(x, y)
+	if _, carry := z.raw.AddOverflow(&a, &b); carry {
+		overflow = true
+	}
+	z.decimals = decimals
+	return z, overflow
+}
+
+// Sub sets z to the difference x-y, and returns z and whether underflow
+// occurred. The result has the larger of the numbers of decimals of x and y,
+// so the difference is exact unless it underflows or bringing a value to that
+// number of decimals overflows. In that case, the raw value of z is the exact
+// raw difference mod 2^256.
+func (z *Fixed) Sub(x, y *Fixed) (*Fixed, bool) {
+	a, b, decimals, overflow := align(x, y)
+	if _, borrow := z.raw.SubOverflow(&a, &b); borrow {
+		overflow = true
+	}
+	z.decimals = decimals
+	return z, overflow
+}
+
+// Mul sets z to the product x*y, rounded according to mode, and returns z and
+// whether overflow occurred. The result has the number of decimals of x.
+// This is wmul and rmul of DSMath, which round HalfUp, for WAD and RAY values.
+// On overflow, the raw value of z is the rounded raw product mod 2^256.
+func (z *Fixed) Mul(x, y *Fixed, mode RoundingMode) (*Fixed, bool) {
+	var (
+		decimals = x.decimals
+		scale    = pow10(y.decimals)
+	)
+	_, overflow := z.raw.MulDivRound(&x.raw, &y.raw, &scale, mode)
+	z.decimals = decimals
+	return z, overflow
+}
+
+// Div sets z to the quotient x/y, rounded according to mode, and returns z and
+// whether overflow occurred. The result has the number of decimals of x.
+// This is wdiv and rdiv of DSMath, which round HalfUp, for WAD and RAY values.
+// On overflow, the raw value of z is the rounded raw quotient mod 2^256.
+// If y == 0, z is set to 0 and no overflow is reported.
+func (z *Fixed) Div(x, y *Fixed, mode RoundingMode) (*Fixed, bool) {
+	var (
+		decimals = x.decimals
+		scale    = pow10(y.decimals)
+	)
+	_, overflow := z.raw.MulDivRound(&x.raw, &scale, &y.raw, mode)
+	z.decimals = decimals
+	return z, overflow
+}
+
+// Pow sets z = x**n by exponentiation by squaring, and returns z and whether
+// overflow occurred. Every intermediate product is rounded according to mode,
+// so the result can differ from the exactly rounded power in the last digits.
+// The result has the number of decimals of x. This is rpow of DSMath, which
+// rounds HalfUp. On overflow, the value of z is unspecified.
+// Pow(x, 0) is 1, also for x == 0.
+func (z *Fixed) Pow(x *Fixed, n uint64, mode RoundingMode) (*Fixed, bool) {
+	var (
+		one      = pow10(x.decimals)
+		res      = one
+		base     = x.raw
+		overflow bool
+	)
+	for n != 0 {
+		if n&1 != 0 {
+			if _, o := res.MulDivRound(&res, &base, &one, mode); o {
+				overflow = true
+			}
+		}
+		n >>= 1
+		if n == 0 {
+			break
+		}
+		if _, o := base.MulDivRound(&base, &base, &one, mode); o {
+			overflow = true
+		}
+	}
+	z.raw, z.decimals = res, x.decimals
+	return z, overflow
+}
+
+// Rescale sets z to x with the given number of decimals, rounded according to
+// mode if decimals is less than the number of decimals of x, and returns z and
+// whether overflow occurred. This converts amounts between tokens with
+// different decimals. On overflow, the raw value of z is the exact raw value
+// mod 2^256. It panics if decimals > MaxDecimals.
+func (z *Fixed) Rescale(x *Fixed, decimals uint8, mode RoundingMode) (*Fixed, bool) {
+	var overflow bool
+	if decimals >= x.decimals {
+		scale := pow10(decimals - x.decimals)
+		_, overflow = z.raw.MulOverflow(&x.raw, &scale)
+	} else {
+		scale := pow10(x.decimals - decimals)
+		z.raw.DivRound(&x.raw, &scale, mode)
+	}
+	z.decimals = decimals
+	return z, overflow
+}
+
+// MulBps sets z to x * bps / 10000, i.e. the given number of basis points of
+// x, rounded according to mode, and returns z and whether overflow occurred.
+// The result has the number of decimals of x.
+func (z *Fixed) MulBps(x *Fixed, bps uint64, mode RoundingMode) (*Fixed, bool) {
+	_, overflow := z.raw.MulDivRound(&x.raw, &Int{bps}, &Int{BasisPoints}, mode)
+	z.decimals = x.decimals
+	return z, overflow
+}
+
+// DivBps sets z to x * 10000 / bps, i.e. the value of which x is the given
+// number of basis points, rounded according to mode, and returns z and whether
+// overflow occurred. The result has the number of decimals of x.
+// If bps == 0, z is set to 0 and no overflow is reported.
+func (z *Fixed) DivBps(x *Fixed, bps uint64, mode RoundingMode) (*Fixed, bool) {
+	_, overflow := z.raw.MulDivRound(&x.raw, &Int{BasisPoints}, &Int{bps}, mode)
+	z.decimals = x.decimals
+	return z, overflow
+}
+
+// String returns the decimal representation of z with exactly as many
+// decimals as z has, e.g. "1.500000000000000000" for 1.5 as a wad.
+func (z *Fixed) String() string {
+	s := z.raw.Dec()
+	d := int(z.decimals)
+	if d == 0 {
+		return s
+	}
+	// Pad with zeroes so that there is one digit before the point.
+	s = padDecimals(s, d+1)
+	return s[:len(s)-d] + "." + s[len(s)-d:]
+}
+
+// padDecimals returns the decimal digits s left-padded with zeroes to a
+// length of at least n.
+func padDecimals(s string, n int) string {
+	if len(s) >= n {
+		return s
+	}
+	buf := make([]byte, n-len(s), n)
+	for i := range buf {
+		buf[i] = '0'
+	}
+	return string(append(buf, s...))
+}
+
+// isDecimalDigits returns true if s consists of the digits 0-9 only
+func isDecimalDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// SetString sets z from the decimal string s, e.g. "1.5", with the given number
+// of decimals. The integer part is parsed like SetFromDecimal does. The
+// fractional part may have fewer decimals than z, but more only if the excess
+// decimals are zero: ErrFixedPrecision is returned otherwise, since the value
+// cannot be represented exactly. It panics if decimals > MaxDecimals.
+func (z *Fixed) SetString(s string, decimals uint8) error {
+	pow10(decimals) // check the range
+	intPart, frac, hasDot := s, "", false
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			intPart, frac, hasDot = s[:i], s[i+1:], true
+			break
+		}
+	}
+	// Remove max one leading +, like SetFromDecimal
+	if len(intPart) > 0 && intPart[0] == '+' {
+		intPart = intPart[1:]
+	}
+	if len(intPart) == 0 || !isDecimalDigits(intPart) ||
+		(hasDot && (len(frac) == 0 || !isDecimalDigits(frac))) {
+		return ErrFixedSyntax
+	}
+	for len(frac) > int(decimals) {
+		if frac[len(frac)-1] != '0' {
+			return ErrFixedPrecision
+		}
+		frac = frac[:len(frac)-1]
+	}
+	buf := make([]byte, 0, len(intPart)+int(decimals))
+	buf = append(buf, intPart...)
+	buf = append(buf, frac...)
+	for i := len(frac); i < int(decimals); i++ {
+		buf = append(buf, '0')
+	}
+	if err := z.raw.SetFromDecimal(string(buf)); err != nil {
+		return err
+	}
+	z.decimals = decimals
+	return nil
+}
+
+// FixedFromDecimal is a convenience-constructor to create a Fixed with the
+// given number of decimals from a decimal string. See SetString.
+func FixedFromDecimal(s string, decimals uint8) (*Fixed, error) {
+	var z Fixed
+	if err := z.SetString(s, decimals); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// MustFixedFromDecimal is a convenience-constructor to create a Fixed with the
+// given number of decimals from a decimal string.
+// Returns a new Fixed and panics if any error occurred.
+func MustFixedFromDecimal(s string, decimals uint8) *Fixed {
+	z, err := FixedFromDecimal(s, decimals)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// MarshalText implements encoding.TextMarshaler, using String.
+func (z *Fixed) MarshalText() ([]byte, error) {
+	return []byte(z.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The input is parsed with
+// SetString, keeping the number of decimals of z.
+func (z *Fixed) UnmarshalText(input []byte) error {
+	return z.SetString(string(input), z.decimals)
+}
@@ -0,0 +1,270 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+var roundingModes = []RoundingMode{Floor, Ceil, HalfUp, HalfEven, HalfDown}
+
+func TestFixedString(t *testing.T) {
+	for _, tc := range []struct {
+		raw      string
+		decimals uint8
+		want     string
+	}{
+		{"0", 0, "0"},
+		{"0", 18, "0.000000000000000000"},
+		{"1500000000000000000", WadDecimals, "1.500000000000000000"},
+		{"5", WadDecimals, "0.000000000000000005"},
+		{"1000000000000000000000000000", RayDecimals, "1.000000000000000000000000000"},
+		{"123456", 2, "1234.56"},
+		{"123456", 6, "0.123456"},
+		{"123456", 7, "0.0123456"},
+		{"1", MaxDecimals, "0." + zeroes(76) + "1"},
+		{twoPow256Sub1, 0, twoPow256Sub1},
+	} {
+		f := NewFixed(MustFromDecimal(tc.raw), tc.decimals)
+		if have := f.String(); have != tc.want {
+			t.Errorf("%s/10^%d: have %s, want %s", tc.raw, tc.decimals, have, tc.want)
+		}
+		// And back
+		var g Fixed
+		if err := g.SetString(tc.want, tc.decimals); err != nil {
+			t.Errorf("%s: %v", tc.want, err)
+		} else if g != *f {
+			t.Errorf("%s: have %v, want %v", tc.want, g.Raw(), f.Raw())
+		}
+	}
+}
+
+func zeroes(n int) string {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = '0'
+	}
+	return string(buf)
+}
+
+func TestFixedSetString(t *testing.T) {
+	for _, tc := range []struct {
+		in       string
+		decimals uint8
+		raw      string
+		err      error
+	}{
+		{"1.5", WadDecimals, "1500000000000000000", nil},
+		{"+1.5", WadDecimals, "1500000000000000000", nil},
+		{"001.5", WadDecimals, "1500000000000000000", nil},
+		{"1", WadDecimals, "1000000000000000000", nil},
+		{"0.000000000000000001", WadDecimals, "1", nil},
+		{"1.250", 2, "125", nil},
+		{"1.255", 2, "", ErrFixedPrecision},
+		{"1.", 2, "", ErrFixedSyntax},
+		{".5", 2, "", ErrFixedSyntax},
+		{"+.5", 2, "", ErrFixedSyntax},
+		{"1.+5", 2, "", ErrFixedSyntax},
+		{"1.-5", 2, "", ErrFixedSyntax},
+		{"1.5e3", 4, "", ErrFixedSyntax},
+		{"1..5", 2, "", ErrFixedSyntax},
+		{"", 2, "", ErrFixedSyntax},
+		{"+", 2, "", ErrFixedSyntax},
+		{"++1", 2, "", ErrFixedSyntax},
+		{"1e3", 2, "", ErrFixedSyntax},
+		{"1a.5", 2, "", ErrFixedSyntax},
+		// 2^256-1 itself fits with 0 decimals, but not with 1
+		{twoPow256Sub1, 1, "", ErrBig256Range},
+	} {
+		f, err := FixedFromDecimal(tc.in, tc.decimals)
+		if tc.err != nil || err != nil {
+			if err != tc.err {
+				t.Errorf("%q: have error %v, want %v", tc.in, err, tc.err)
+			}
+			continue
+		}
+		if want := MustFromDecimal(tc.raw); !f.Raw().Eq(want) || f.Decimals() != tc.decimals {
+			t.Errorf("%q: have %v/10^%d, want %v/10^%d", tc.in, f.Raw(), f.Decimals(), want, tc.decimals)
+		}
+	}
+}
+
+func TestFixedJSON(t *testing.T) {
+	type account struct {
+		Balance *Fixed
+	}
+	in := account{MustFixedFromDecimal("12.345", WadDecimals)}
+	enc, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Balance":"12.345000000000000000"}`; string(enc) != want {
+		t.Fatalf("have %s, want %s", enc, want)
+	}
+	out := account{NewWad(new(Int))}
+	if err := json.Unmarshal(enc, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Balance.Cmp(in.Balance) != 0 {
+		t.Errorf("have %v, want %v", out.Balance, in.Balance)
+	}
+}
+
+// TestFixedArithmetic checks Mul, Div, Rescale, MulBps and DivBps against
+// big.Int, for random raw values and each rounding mode.
+func TestFixedArithmetic(t *testing.T) {
+	decimals := []uint8{0, 6, WadDecimals, RayDecimals, MaxDecimals}
+	check := func(name string, mode RoundingMode, have *Fixed, overflow bool, want *big.Int, wantDecimals uint8) {
+		t.Helper()
+		wantOverflow := want.Cmp(bigtt256) >= 0
+		if overflow != wantOverflow {
+			t.Errorf("%s %v: have overflow %v, want %v", name, mode, overflow, wantOverflow)
+		}
+		if w, _ := FromBig(want); !have.Raw().Eq(w) || have.Decimals() != wantDecimals {
+			t.Errorf("%s %v: have %v/10^%d, want %v/10^%d", name, mode, have.Raw(), have.Decimals(), w, wantDecimals)
+		}
+	}
+	for i := 0; i < 200; i++ {
+		var (
+			xd = decimals[i%len(decimals)]
+			yd = decimals[(i/len(decimals))%len(decimals)]
+			// Shrink some of the values, so that not everything overflows
+			x    = NewFixed(new(Int).Rsh(randNum(), uint(i%4)*64), xd)
+			y    = NewFixed(new(Int).Rsh(randNum(), uint(i%3)*96), yd)
+			bps  = randNum()[0] % 20000
+			xBig = x.raw.ToBig()
+			yBig = y.raw.ToBig()
+			yOne = pow10Big(yd)
+		)
+		for _, mode := range roundingModes {
+			round := bigDivRound(mode)
+			z, overflow := new(Fixed).Mul(x, y, mode)
+			check("Mul", mode, z, overflow, round(new(big.Int), new(big.Int).Mul(xBig, yBig), yOne), xd)
+			z, overflow = new(Fixed).Div(x, y, mode)
+			check("Div", mode, z, overflow, round(new(big.Int), new(big.Int).Mul(xBig, yOne), yBig), xd)
+			z, overflow = new(Fixed).Rescale(x, yd, mode)
+			check("Rescale", mode, z, overflow, round(new(big.Int), new(big.Int).Mul(xBig, pow10Big(yd)), pow10Big(xd)), yd)
+			z, overflow = new(Fixed).MulBps(x, bps, mode)
+			check("MulBps", mode, z, overflow, round(new(big.Int), new(big.Int).Mul(xBig, new(big.Int).SetUint64(bps)), big.NewInt(BasisPoints)), xd)
+			z, overflow = new(Fixed).DivBps(x, bps, mode)
+			check("DivBps", mode, z, overflow, round(new(big.Int), new(big.Int).Mul(xBig, big.NewInt(BasisPoints)), new(big.Int).SetUint64(bps)), xd)
+			// Aliasing the result with the arguments
+			z = new(Fixed).Set(x)
+			z, overflow = z.Mul(z, y, mode)
+			check("Mul aliased", mode, z, overflow, round(new(big.Int), new(big.Int).Mul(xBig, yBig), yOne), xd)
+		}
+		// Add, Sub and Cmp work in the larger number of decimals
+		var (
+			d      = xd
+			xScale = big.NewInt(1)
+			yScale = big.NewInt(1)
+		)
+		if yd > xd {
+			d = yd
+			xScale = pow10Big(yd - xd)
+		} else {
+			yScale = pow10Big(xd - yd)
+		}
+		a := new(big.Int).Mul(xBig, xScale)
+		b := new(big.Int).Mul(yBig, yScale)
+		z, overflow := new(Fixed).Add(x, y)
+		sum := new(big.Int).Add(a, b)
+		if have, want := z.Raw(), new(Int).Add(mustFromBigMod(a), mustFromBigMod(b)); !have.Eq(want) || z.Decimals() != d || overflow != (a.Cmp(bigtt256) >= 0 || b.Cmp(bigtt256) >= 0 || sum.Cmp(bigtt256) >= 0) {
+			t.Errorf("Add: have %v/10^%d overflow %v, want %v/10^%d", have, z.Decimals(), overflow, want, d)
+		}
+		z, underflow := new(Fixed).Sub(x, y)
+		if have, want := z.Raw(), new(Int).Sub(mustFromBigMod(a), mustFromBigMod(b)); !have.Eq(want) || z.Decimals() != d || underflow != (a.Cmp(bigtt256) >= 0 || b.Cmp(bigtt256) >= 0 || a.Cmp(b) < 0) {
+			t.Errorf("Sub: have %v/10^%d underflow %v, want %v/10^%d", have, z.Decimals(), underflow, want, d)
+		}
+		if have, want := x.Cmp(y), a.Cmp(b); have != want {
+			t.Errorf("Cmp: have %d, want %d", have, want)
+		}
+	}
+}
+
+func pow10Big(n uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func mustFromBigMod(b *big.Int) *Int {
+	z, _ := FromBig(b)
+	return z
+}
+
+func TestFixedPow(t *testing.T) {
+	for _, tc := range []struct {
+		x        string
+		n        uint64
+		decimals uint8
+		mode     RoundingMode
+		want     string
+	}{
+		{"1.05", 2, WadDecimals, HalfUp, "1.1025"},
+		{"2", 10, WadDecimals, HalfUp, "1024"},
+		{"0", 0, WadDecimals, HalfUp, "1"},
+		{"0", 5, WadDecimals, HalfUp, "0"},
+		{"123.456", 1, WadDecimals, HalfUp, "123.456"},
+		// 0.5^61 = 4.3368...e-19 rounds to 0 or a unit in the last place
+		{"0.5", 61, WadDecimals, Floor, "0"},
+		{"0.5", 61, WadDecimals, Ceil, "0.000000000000000001"},
+	} {
+		x := MustFixedFromDecimal(tc.x, tc.decimals)
+		have, overflow := new(Fixed).Pow(x, tc.n, tc.mode)
+		if overflow {
+			t.Errorf("%s^%d: unexpected overflow", tc.x, tc.n)
+			continue
+		}
+		if want := MustFixedFromDecimal(tc.want, tc.decimals); have.Cmp(want) != 0 {
+			t.Errorf("%s^%d: have %v, want %v", tc.x, tc.n, have, want)
+		}
+	}
+	// A power which overflows
+	if _, overflow := new(Fixed).Pow(MustFixedFromDecimal("10", WadDecimals), 60, HalfUp); !overflow {
+		t.Errorf("expected overflow")
+	}
+	// Random values against the same algorithm on big.Int
+	for i := 0; i < 100; i++ {
+		var (
+			one  = pow10Big(RayDecimals)
+			x    = new(Int).SetUint64(randNum()[0])
+			n    = randNum()[0] % 1000
+			mode = roundingModes[i%len(roundingModes)]
+		)
+		x.Add(x, MustFromBig(one))
+		have, overflow := new(Fixed).Pow(NewRay(x), n, mode)
+		want := bigFixedPow(x.ToBig(), n, one, mode)
+		if wantOverflow := want == nil; overflow != wantOverflow {
+			t.Errorf("%v^%d: have overflow %v, want %v", x, n, overflow, wantOverflow)
+		} else if !overflow && !have.Raw().Eq(MustFromBig(want)) {
+			t.Errorf("%v^%d %v: have %v, want %v", x, n, mode, have.Raw(), want)
+		}
+	}
+}
+
+// bigFixedPow implements Pow on big.Int: returns nil on overflow
+func bigFixedPow(x *big.Int, n uint64, one *big.Int, mode RoundingMode) *big.Int {
+	var (
+		round = bigDivRound(mode)
+		res   = new(big.Int).Set(one)
+		base  = new(big.Int).Set(x)
+	)
+	for n != 0 {
+		if n&1 != 0 {
+			if round(res, res.Mul(res, base), one).Cmp(bigtt256) >= 0 {
+				return nil
+			}
+		}
+		n >>= 1
+		if n == 0 {
+			break
+		}
+		if round(base, base.Mul(base, base), one).Cmp(bigtt256) >= 0 {
+			return nil
+		}
+	}
+	return res
+}
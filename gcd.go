@@ -0,0 +1,404 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// isOne returns true if z == 1
+func (z *Int) isOne() bool {
+	return (z[0] ^ 1 | z[1] | z[2] | z[3]) == 0
+}
+
+// GCD sets z to the greatest common divisor of x and y, and returns z.
+// GCD(0, y) is y and GCD(x, 0) is x, so GCD(0, 0) = 0.
+func (z *Int) GCD(x, y *Int) *Int {
+	if x.IsZero() {
+		return z.Set(y)
+	}
+	if y.IsZero() {
+		return z.Set(x)
+	}
+	// Binary GCD (Stein's algorithm). The common power of two is
+	// stripped off first and restored at the end.
+	if x.IsUint64() && y.IsUint64() {
+		return z.SetUint64(gcd64(x[0], y[0]))
+	}
+	var (
+		u, v = *x, *y
+		k    = uint(u.TrailingZeros())
+	)
+	if tz := uint(v.TrailingZeros()); tz < k {
+		k = tz
+	}
+	u.Rsh(&u, uint(u.TrailingZeros()))
+	for {
+		// u is odd here
+		v.Rsh(&v, uint(v.TrailingZeros()))
+		if u.Gt(&v) {
+			u, v = v, u
+		}
+		v.Sub(&v, &u)
+		if v.IsZero() {
+			break
+		}
+		if u.IsUint64() && v.IsUint64() {
+			u.SetUint64(gcd64(u[0], v[0]))
+			break
+		}
+	}
+	return z.Lsh(&u, k)
+}
+
+// gcd64 is the binary GCD on non-zero uint64 values.
+func gcd64(u, v uint64) uint64 {
+	if u == 0 {
+		return v
+	}
+	if v == 0 {
+		return u
+	}
+	k := bits.TrailingZeros64(u | v)
+	u >>= uint(bits.TrailingZeros64(u))
+	for {
+		v >>= uint(bits.TrailingZeros64(v))
+		if u > v {
+			u, v = v, u
+		}
+		v -= u
+		if v == 0 {
+			return u << uint(k)
+		}
+	}
+}
+
+// LCM sets z to the least common multiple of x and y, and returns z and
+// whether overflow occurred. On overflow, z is set to the lower 256 bits of
+// the result.
+// If x == 0 or y == 0, z is set to 0.
+func (z *Int) LCM(x, y *Int) (*Int, bool) {
+	if x.IsZero() || y.IsZero() {
+		return z.Clear(), false
+	}
+	var g Int
+	g.GCD(x, y)
+	g.Div(x, &g)
+	return z.MulOverflow(&g, y)
+}
+
+// ExtGCD sets z to the greatest common divisor of x and y, and sets a and b
+// to Bézout coefficients such that z = a*x + b*y. It returns z.
+//
+// The coefficients are the minimal ones produced by the extended Euclidean
+// algorithm, and are stored as two's complement signed integers (see Sign
+// and Abs). They always fit: |a| <= y/(2z) and |b| <= x/(2z), except when
+// one of x or y divides the other, in which case |a|, |b| <= 1.
+//
+//	ExtGCD(x, 0) = x, with a = 1, b = 0
+//	ExtGCD(0, y) = y, with a = 0, b = 1
+//	ExtGCD(0, 0) = 0, with a = 0, b = 0
+func (z *Int) ExtGCD(x, y, a, b *Int) *Int {
+	if x.IsZero() && y.IsZero() {
+		a.Clear()
+		b.Clear()
+		return z.Clear()
+	}
+	// The coefficients of the Euclidean remainder sequence alternate in
+	// sign, so only their magnitudes are tracked here:
+	//
+	//	s[i+1] = s[i-1] + q[i]*s[i]
+	//	t[i+1] = t[i-1] + q[i]*t[i]
+	//
+	// with sign(s[i]) = (-1)^i and sign(t[i]) = (-1)^(i+1).
+	var (
+		r0, r1 = *x, *y
+		s0, s1 = Int{1, 0, 0, 0}, Int{}
+		t0, t1 = Int{}, Int{1, 0, 0, 0}
+		q, r   Int
+		s2, t2 Int
+		odd    bool // parity of the index of r0
+	)
+	for !r1.IsZero() {
+		q.DivMod(&r0, &r1, &r)
+		// The coefficients belonging to a zero remainder are never needed,
+		// and might not fit in 256 bits.
+		if r.IsZero() {
+			r0, s0, t0 = r1, s1, t1
+			odd = !odd
+			break
+		}
+		s2.Add(s2.Mul(&q, &s1), &s0)
+		t2.Add(t2.Mul(&q, &t1), &t0)
+		r0, r1 = r1, r
+		s0, s1 = s1, s2
+		t0, t1 = t1, t2
+		odd = !odd
+	}
+	if odd {
+		s0.Neg(&s0)
+	} else {
+		t0.Neg(&t0)
+	}
+	a.Set(&s0)
+	b.Set(&t0)
+	return z.Set(&r0)
+}
+
+// ModInverse sets z to the multiplicative inverse of x in the ring ℤ/mℤ,
+// and returns z and true. If x and m are not relatively prime, x has no
+// inverse: z is set to 0 and false is returned.
+// If m == 0, z is set to 0 and false is returned (OBS: differs from the big.Int)
+func (z *Int) ModInverse(x, m *Int) (*Int, bool) {
+	if m.IsZero() {
+		return z.Clear(), false
+	}
+	if m.isOne() {
+		return z.Clear(), true
+	}
+	var (
+		mod = *m
+		u   Int
+	)
+	u.Mod(x, &mod)
+	if u.IsZero() {
+		return z.Clear(), false
+	}
+	if mod[0]&1 == 1 {
+		return z.modInverseOdd(&u, &mod)
+	}
+	// For an even m, x must be odd. The inverse is derived from the
+	// inverse c of m modulo u, which has an odd modulus:
+	//
+	//	m*c = 1 + u*k  =>  u*(-k) = 1 mod m
+	if u[0]&1 == 0 {
+		return z.Clear(), false
+	}
+	if u.isOne() {
+		return z.SetOne(), true
+	}
+	var c Int
+	if c.Mod(&mod, &u); c.IsZero() {
+		return z.Clear(), false
+	}
+	if _, ok := c.modInverseOdd(&c, &u); !ok {
+		return z.Clear(), false
+	}
+	var (
+		p    [8]uint64
+		quot [8]uint64
+	)
+	umul(&mod, &c, &p)
+	// m*c - 1 can not underflow, m*c >= 2
+	for i := 0; i < len(p); i++ {
+		p[i]--
+		if p[i] != ^uint64(0) {
+			break
+		}
+	}
+	udivrem(quot[:], p[:], &u, nil)
+	k := Int{quot[0], quot[1], quot[2], quot[3]}
+	return z.Sub(&mod, &k), true
+}
+
+// modInverseOdd computes the inverse of 0 < y < m for an odd modulus m >= 3.
+//
+// It implements the optimized binary GCD from T. Pornin, "Optimized Binary GCD
+// for Modular Inversion" (https://eprint.iacr.org/2020/972). Each outer
+// iteration runs 31 steps of the binary GCD on 64-bit approximations of a and
+// b, then applies the accumulated transformation to the full values. The
+// invariants are
+//
+//	a*2^(31t) = y*u mod m
+//	b*2^(31t) = y*v mod m
+//
+// where t is the number of outer iterations. The factor 2^-31 is removed
+// from u and v on every iteration, Montgomery-style, so that v is the inverse
+// of y when b reaches gcd(y, m) = 1.
+func (z *Int) modInverseOdd(y, m *Int) (*Int, bool) {
+	var (
+		a, b = *y, *m
+		u, v = Int{1, 0, 0, 0}, Int{}
+		mInv = negInverse64(m[0])
+	)
+
+	for !a.IsZero() {
+		var (
+			// Approximations of a and b: the low 31 bits, and the 33 bits
+			// below the top bit of max(a, b)
+			n              = uint(bitLen256(a[3]|b[3], a[2]|b[2], a[1]|b[1], a[0]|b[0]))
+			aa             = a[0]&(1<<31-1) | approxTop33(&a, n)<<31
+			bb             = b[0]&(1<<31-1) | approxTop33(&b, n)<<31
+			f0, g0, f1, g1 = int64(1), int64(0), int64(0), int64(1)
+		)
+		for i := 0; i < 31; i++ {
+			// Branch-free form of:
+			//
+			//	if aa is odd {
+			//		if aa < bb {
+			//			swap (aa, f0, g0) with (bb, f1, g1)
+			//		}
+			//		aa, f0, g0 = aa-bb, f0-f1, g0-g1
+			//	}
+			_, borrow := bits.Sub64(aa, bb, 0)
+			var (
+				odd  = -(aa & 1)
+				swap = odd & -borrow
+				t    = (aa ^ bb) & swap
+			)
+			aa, bb = aa^t, bb^t
+			tf := (f0 ^ f1) & int64(swap)
+			f0, f1 = f0^tf, f1^tf
+			tg := (g0 ^ g1) & int64(swap)
+			g0, g1 = g0^tg, g1^tg
+			aa -= bb & odd
+			f0 -= f1 & int64(odd)
+			g0 -= g1 & int64(odd)
+			aa >>= 1
+			f1 <<= 1
+			g1 <<= 1
+		}
+		na, negA := linearCombRsh31(&a, &b, f0, g0)
+		nb, negB := linearCombRsh31(&a, &b, f1, g1)
+		if negA {
+			f0, g0 = -f0, -g0
+		}
+		if negB {
+			f1, g1 = -f1, -g1
+		}
+		a, b = na, nb
+		nu := linearCombMont31(&u, &v, f0, g0, m, mInv)
+		nv := linearCombMont31(&u, &v, f1, g1, m, mInv)
+		u, v = nu, nv
+	}
+	if !b.isOne() {
+		return z.Clear(), false
+	}
+	return z.Set(&v), true
+}
+
+// bitLen256 returns the bit length of the number with the given words,
+// most significant first.
+func bitLen256(w3, w2, w1, w0 uint64) int {
+	switch {
+	case w3 != 0:
+		return 192 + bits.Len64(w3)
+	case w2 != 0:
+		return 128 + bits.Len64(w2)
+	case w1 != 0:
+		return 64 + bits.Len64(w1)
+	default:
+		return bits.Len64(w0)
+	}
+}
+
+// approxTop33 returns the 33 bits of x at bit positions [n-33, n), or the
+// bits from position 31 upwards if n < 64.
+func approxTop33(x *Int, n uint) uint64 {
+	if n < 64 {
+		return x[0] >> 31
+	}
+	var (
+		pos   = n - 33
+		w, sh = pos / 64, pos % 64
+		t     = x[w] >> sh
+	)
+	if sh > 31 {
+		t |= x[w+1] << (64 - sh)
+	}
+	return t & (1<<33 - 1)
+}
+
+// mulSigned computes x*f as a 320-bit two's complement number.
+func mulSigned(x *Int, f int64) (r [5]uint64) {
+	m := uint64(f)
+	if f < 0 {
+		m = -m
+	}
+	var carry uint64
+	carry, r[0] = bits.Mul64(x[0], m)
+	carry, r[1] = umulHop(carry, x[1], m)
+	carry, r[2] = umulHop(carry, x[2], m)
+	r[4], r[3] = umulHop(carry, x[3], m)
+	if f < 0 {
+		neg320(&r)
+	}
+	return r
+}
+
+// neg320 negates a 320-bit two's complement number.
+func neg320(r *[5]uint64) {
+	var borrow uint64
+	r[0], borrow = bits.Sub64(0, r[0], 0)
+	r[1], borrow = bits.Sub64(0, r[1], borrow)
+	r[2], borrow = bits.Sub64(0, r[2], borrow)
+	r[3], borrow = bits.Sub64(0, r[3], borrow)
+	r[4], _ = bits.Sub64(0, r[4], borrow)
+}
+
+// add320 computes x += y on 320-bit numbers.
+func add320(x, y *[5]uint64) {
+	var carry uint64
+	x[0], carry = bits.Add64(x[0], y[0], 0)
+	x[1], carry = bits.Add64(x[1], y[1], carry)
+	x[2], carry = bits.Add64(x[2], y[2], carry)
+	x[3], carry = bits.Add64(x[3], y[3], carry)
+	x[4], _ = bits.Add64(x[4], y[4], carry)
+}
+
+// srsh320by31 performs an arithmetic right shift by 31 bits on a 320-bit number.
+func srsh320by31(x *[5]uint64) {
+	x[0] = x[0]>>31 | x[1]<<33
+	x[1] = x[1]>>31 | x[2]<<33
+	x[2] = x[2]>>31 | x[3]<<33
+	x[3] = x[3]>>31 | x[4]<<33
+	x[4] = uint64(int64(x[4]) >> 31)
+}
+
+// linearCombRsh31 computes |x*f + y*g| / 2^31, and whether x*f + y*g is
+// negative. The division must be exact, and the result must fit in 256 bits.
+func linearCombRsh31(x, y *Int, f, g int64) (Int, bool) {
+	r := mulSigned(x, f)
+	s := mulSigned(y, g)
+	add320(&r, &s)
+	srsh320by31(&r)
+	neg := int64(r[4]) < 0
+	if neg {
+		neg320(&r)
+	}
+	return Int{r[0], r[1], r[2], r[3]}, neg
+}
+
+// linearCombMont31 computes (x*f + y*g) / 2^31 mod m, for x, y < m, an odd
+// m, |f| + |g| <= 2^31, and mInv = -m^-1 mod 2^64.
+func linearCombMont31(x, y *Int, f, g int64, m *Int, mInv uint64) Int {
+	r := mulSigned(x, f)
+	s := mulSigned(y, g)
+	add320(&r, &s)
+	// Add a multiple of m that clears the lowest 31 bits
+	c := (r[0] * mInv) & (1<<31 - 1)
+	var carry uint64
+	carry, s[0] = bits.Mul64(m[0], c)
+	carry, s[1] = umulHop(carry, m[1], c)
+	carry, s[2] = umulHop(carry, m[2], c)
+	s[4], s[3] = umulHop(carry, m[3], c)
+	add320(&r, &s)
+	srsh320by31(&r)
+	// The result is in [-m, 2m)
+	mm := [5]uint64{m[0], m[1], m[2], m[3], 0}
+	if int64(r[4]) < 0 {
+		add320(&r, &mm)
+	} else {
+		var t [5]uint64
+		var borrow uint64
+		t[0], borrow = bits.Sub64(r[0], mm[0], 0)
+		t[1], borrow = bits.Sub64(r[1], mm[1], borrow)
+		t[2], borrow = bits.Sub64(r[2], mm[2], borrow)
+		t[3], borrow = bits.Sub64(r[3], mm[3], borrow)
+		t[4], borrow = bits.Sub64(r[4], 0, borrow)
+		if borrow == 0 {
+			r = t
+		}
+	}
+	return Int{r[0], r[1], r[2], r[3]}
+}
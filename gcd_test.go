@@ -0,0 +1,140 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+func checkExtGCD(t *testing.T, x, y Int) {
+	t.Helper()
+	var (
+		xc, yc  = x, y
+		a, b, g Int
+	)
+	g.ExtGCD(&xc, &yc, &a, &b)
+	if !xc.Eq(&x) || !yc.Eq(&y) {
+		t.Fatalf("ExtGCD(%v, %v): arguments modified", x.Hex(), y.Hex())
+	}
+	var (
+		bx, by = x.ToBig(), y.ToBig()
+		ba, bb = bigS256(a.ToBig()), bigS256(b.ToBig())
+		want   = new(big.Int).GCD(nil, nil, bx, by)
+	)
+	if !g.Eq(MustFromBig(want)) {
+		t.Fatalf("ExtGCD(%v, %v)\nwant : %#x\nhave : %#x", x.Hex(), y.Hex(), want, &g)
+	}
+	// a*x + b*y must equal the gcd exactly
+	sum := new(big.Int).Mul(ba, bx)
+	sum.Add(sum, new(big.Int).Mul(bb, by))
+	if sum.Cmp(want) != 0 {
+		t.Fatalf("ExtGCD(%v, %v): a=%v b=%v, a*x+b*y = %v, want %v", x.Hex(), y.Hex(), ba, bb, sum, want)
+	}
+	// The coefficients must be minimal
+	if want.Sign() != 0 {
+		maxA := new(big.Int).Div(by, new(big.Int).Lsh(want, 1))
+		maxB := new(big.Int).Div(bx, new(big.Int).Lsh(want, 1))
+		one := big.NewInt(1)
+		if maxA.Cmp(one) < 0 {
+			maxA = one
+		}
+		if maxB.Cmp(one) < 0 {
+			maxB = one
+		}
+		if new(big.Int).Abs(ba).Cmp(maxA) > 0 || new(big.Int).Abs(bb).Cmp(maxB) > 0 {
+			t.Fatalf("ExtGCD(%v, %v): coefficients not minimal: a=%v b=%v", x.Hex(), y.Hex(), ba, bb)
+		}
+	}
+	// Aliasing of the result with the arguments
+	a2, b2 := x, y
+	if g2 := a2.ExtGCD(&a2, &b2, &a2, &b2); g2 != &a2 || !b2.Eq(&b) {
+		t.Fatalf("ExtGCD(%v, %v): aliased result mismatch", x.Hex(), y.Hex())
+	}
+}
+
+func TestExtGCD(t *testing.T) {
+	for _, inputs := range binTestCases {
+		x, y := MustFromHex(inputs[0]), MustFromHex(inputs[1])
+		checkExtGCD(t, *x, *y)
+		checkExtGCD(t, *y, *x)
+	}
+	for i := 0; i < 10000; i++ {
+		checkExtGCD(t, *randNum(), *randNum())
+	}
+	// Consecutive Fibonacci numbers take the most steps
+	a, b := NewInt(1), NewInt(1)
+	for {
+		checkExtGCD(t, *a, *b)
+		if _, overflow := a.AddOverflow(a, b); overflow {
+			break
+		}
+		a, b = b, a
+	}
+}
+
+func FuzzExtGCD(f *testing.F) {
+	f.Fuzz(func(t *testing.T, x0, x1, x2, x3, y0, y1, y2, y3 uint64) {
+		checkExtGCD(t, Int{x0, x1, x2, x3}, Int{y0, y1, y2, y3})
+	})
+}
+
+func TestModInverse(t *testing.T) {
+	check := func(x, m *Int) {
+		t.Helper()
+		have, ok := new(Int).ModInverse(x, m)
+		if m.IsZero() {
+			if ok || !have.IsZero() {
+				t.Fatalf("ModInverse(%v, 0): have %v, %v", x.Hex(), have.Hex(), ok)
+			}
+			return
+		}
+		want := new(big.Int).ModInverse(x.ToBig(), m.ToBig())
+		if ok != (want != nil) {
+			t.Fatalf("ModInverse(%v, %v): have ok=%v, want %v", x.Hex(), m.Hex(), ok, want)
+		}
+		if ok && !have.Eq(MustFromBig(want)) {
+			t.Fatalf("ModInverse(%v, %v)\nwant : %#x\nhave : %#x", x.Hex(), m.Hex(), want, have)
+		}
+	}
+	// secp256k1 field prime and group order, and an even modulus
+	moduli := []*Int{
+		MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+		MustFromHex("0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"),
+		MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000000"),
+		MustFromHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+		NewInt(1),
+		NewInt(2),
+		NewInt(0),
+	}
+	for _, m := range moduli {
+		for i := 0; i < 1000; i++ {
+			check(randNum(), m)
+		}
+		check(new(Int), m)
+		check(NewInt(1), m)
+		check(new(Int).SetAllOne(), m)
+	}
+	for i := 0; i < 10000; i++ {
+		check(randNum(), randNum())
+	}
+}
+
+func TestLCMOverflow(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		x, y := randNum(), randNum()
+		_, overflow := new(Int).LCM(x, y)
+		var want bool
+		if !x.IsZero() && !y.IsZero() {
+			bx, by := x.ToBig(), y.ToBig()
+			lcm := new(big.Int).Mul(bx, by)
+			lcm.Div(lcm, new(big.Int).GCD(nil, nil, bx, by))
+			want = lcm.BitLen() > 256
+		}
+		if overflow != want {
+			t.Fatalf("LCM(%v, %v): have overflow %v, want %v", x.Hex(), y.Hex(), overflow, want)
+		}
+	}
+}
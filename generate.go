@@ -0,0 +1,9 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+// The uint128, uint192 and uint384 packages are generated from the templates
+// in internal/gen/templates.
+//go:generate go run ./internal/gen
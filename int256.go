@@ -0,0 +1,497 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Int256 is a signed 256-bit integer in two's complement representation,
+// with the range [-2^255, 2^255-1].
+//
+// Int256 has the same layout as Int, so the two can be converted freely
+// with (*Int)(x) and (*Int256)(x), which reinterpret the bits.
+type Int256 Int
+
+// ErrInt256Range is returned when a number is outside the range of Int256.
+var ErrInt256Range = errors.New("number out of int256 range")
+
+var (
+	// MinInt256 is the smallest value of an Int256, -2^255
+	MinInt256 = Int256{0, 0, 0, 0x8000000000000000}
+	// MaxInt256 is the largest value of an Int256, 2^255-1
+	MaxInt256 = Int256{^uint64(0), ^uint64(0), ^uint64(0), 0x7fffffffffffffff}
+)
+
+// NewInt256 returns a new initialized Int256.
+func NewInt256(val int64) *Int256 {
+	z := &Int256{}
+	z.SetInt64(val)
+	return z
+}
+
+// u returns z as an unsigned Int, sharing the same memory.
+func (z *Int256) u() *Int {
+	return (*Int)(z)
+}
+
+// Set sets z to x and returns z.
+func (z *Int256) Set(x *Int256) *Int256 {
+	*z = *x
+	return z
+}
+
+// SetInt64 sets z to the value of x, and returns z.
+func (z *Int256) SetInt64(x int64) *Int256 {
+	z[0] = uint64(x)
+	sign := uint64(x >> 63)
+	z[1], z[2], z[3] = sign, sign, sign
+	return z
+}
+
+// Clear sets z to 0
+func (z *Int256) Clear() *Int256 {
+	*z = Int256{}
+	return z
+}
+
+// Clone creates a new Int256 identical to z
+func (z *Int256) Clone() *Int256 {
+	return &Int256{z[0], z[1], z[2], z[3]}
+}
+
+// IsZero returns true if z == 0
+func (z *Int256) IsZero() bool {
+	return z.u().IsZero()
+}
+
+// Sign returns:
+//
+//	-1 if z <  0
+//	 0 if z == 0
+//	+1 if z >  0
+func (z *Int256) Sign() int {
+	return z.u().Sign()
+}
+
+// IsInt64 reports whether z can be represented as an int64.
+func (z *Int256) IsInt64() bool {
+	sign := uint64(int64(z[0]) >> 63)
+	return z[1] == sign && z[2] == sign && z[3] == sign
+}
+
+// Int64 returns the lower 64 bits of z as an int64.
+// If z cannot be represented in an int64, the result is undefined.
+func (z *Int256) Int64() int64 {
+	return int64(z[0])
+}
+
+// Cmp compares z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Int256) Cmp(x *Int256) int {
+	switch {
+	case z.u().Slt(x.u()):
+		return -1
+	case z.u().Sgt(x.u()):
+		return 1
+	}
+	return 0
+}
+
+// Eq returns true if z == x
+func (z *Int256) Eq(x *Int256) bool {
+	return *z == *x
+}
+
+// Lt returns true if z < x
+func (z *Int256) Lt(x *Int256) bool {
+	return z.u().Slt(x.u())
+}
+
+// Gt returns true if z > x
+func (z *Int256) Gt(x *Int256) bool {
+	return z.u().Sgt(x.u())
+}
+
+// Neg sets z to -x and returns z.
+// -MinInt256 overflows, and results in MinInt256.
+func (z *Int256) Neg(x *Int256) *Int256 {
+	z.u().Neg(x.u())
+	return z
+}
+
+// Abs sets z to |x| and returns z.
+// |MinInt256| overflows, and results in MinInt256.
+func (z *Int256) Abs(x *Int256) *Int256 {
+	z.u().Abs(x.u())
+	return z
+}
+
+// Add sets z to the sum x+y, wrapping around on overflow, and returns z.
+func (z *Int256) Add(x, y *Int256) *Int256 {
+	z.u().Add(x.u(), y.u())
+	return z
+}
+
+// AddOverflow sets z to the sum x+y, and returns z and true if overflow occurred
+func (z *Int256) AddOverflow(x, y *Int256) (*Int256, bool) {
+	xNeg, yNeg := x.isNeg(), y.isNeg()
+	z.u().Add(x.u(), y.u())
+	return z, xNeg == yNeg && z.isNeg() != xNeg
+}
+
+// Sub sets z to the difference x-y, wrapping around on overflow, and returns z.
+func (z *Int256) Sub(x, y *Int256) *Int256 {
+	z.u().Sub(x.u(), y.u())
+	return z
+}
+
+// SubOverflow sets z to the difference x-y and returns z and true if overflow occurred
+func (z *Int256) SubOverflow(x, y *Int256) (*Int256, bool) {
+	xNeg, yNeg := x.isNeg(), y.isNeg()
+	z.u().Sub(x.u(), y.u())
+	return z, xNeg != yNeg && z.isNeg() != xNeg
+}
+
+// Mul sets z to the product x*y, wrapping around on overflow, and returns z.
+func (z *Int256) Mul(x, y *Int256) *Int256 {
+	z.u().Mul(x.u(), y.u())
+	return z
+}
+
+// MulOverflow sets z to the product x*y, and returns z and true if overflow occurred
+func (z *Int256) MulOverflow(x, y *Int256) (*Int256, bool) {
+	var (
+		neg          = x.isNeg() != y.isNeg()
+		xAbs, yAbs   Int
+		_, overflow  = xAbs.MulOverflow(xAbs.Abs(x.u()), yAbs.Abs(y.u()))
+		magnitudeMax = Int{0, 0, 0, 0x8000000000000000}
+	)
+	// The magnitude of the product is in xAbs. It can be at most 2^255 for a
+	// negative result, and 2^255-1 for a positive one.
+	if neg {
+		overflow = overflow || xAbs.Gt(&magnitudeMax)
+	} else {
+		overflow = overflow || !xAbs.Lt(&magnitudeMax)
+	}
+	z.u().Mul(x.u(), y.u())
+	return z, overflow
+}
+
+// Quo sets z to the quotient x/y, truncated towards zero, and returns z.
+// MinInt256 / -1 overflows, and results in MinInt256.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int256) Quo(x, y *Int256) *Int256 {
+	z, _ = z.QuoOverflow(x, y)
+	return z
+}
+
+// QuoOverflow sets z to the quotient x/y, truncated towards zero, and returns
+// z and true if overflow occurred. The only overflowing case is MinInt256 / -1,
+// which results in MinInt256.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int256) QuoOverflow(x, y *Int256) (*Int256, bool) {
+	if y.IsZero() {
+		return z.Clear(), false
+	}
+	var (
+		overflow   = x.Eq(&MinInt256) && y.u().Eq(&Int{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)})
+		neg        = x.isNeg() != y.isNeg()
+		xAbs, yAbs Int
+	)
+	xAbs.Abs(x.u())
+	yAbs.Abs(y.u())
+	z.u().Div(&xAbs, &yAbs)
+	if neg {
+		z.u().Neg(z.u())
+	}
+	return z, overflow
+}
+
+// Rem sets z to the remainder x%y, with the sign of x, and returns z.
+// Rem implements truncated modulus, like Go's % operator.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int256) Rem(x, y *Int256) *Int256 {
+	if y.IsZero() {
+		return z.Clear()
+	}
+	var (
+		neg        = x.isNeg()
+		xAbs, yAbs Int
+	)
+	xAbs.Abs(x.u())
+	yAbs.Abs(y.u())
+	z.u().Mod(&xAbs, &yAbs)
+	if neg {
+		z.u().Neg(z.u())
+	}
+	return z
+}
+
+// isNeg returns true if z < 0
+func (z *Int256) isNeg() bool {
+	return z[3] >= 0x8000000000000000
+}
+
+// magnitude returns |z| as an unsigned Int. It does not overflow, |MinInt256|
+// is 2^255.
+func (z *Int256) magnitude() *Int {
+	return new(Int).Abs(z.u())
+}
+
+// setSigned sets z to the magnitude, negated if neg is set, and returns
+// ErrInt256Range if the result does not fit.
+func (z *Int256) setSigned(magnitude *Int, neg bool) error {
+	limit := Int{0, 0, 0, 0x8000000000000000}
+	if neg {
+		if magnitude.Gt(&limit) {
+			return ErrInt256Range
+		}
+		z.u().Neg(magnitude)
+		return nil
+	}
+	if !magnitude.Lt(&limit) {
+		return ErrInt256Range
+	}
+	z.u().Set(magnitude)
+	return nil
+}
+
+// splitSign removes a leading '-' or '+' from s, and reports whether it was
+// '-', and whether the rest of s has no further sign.
+func splitSign(s string) (rest string, neg, ok bool) {
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg, s = s[0] == '-', s[1:]
+	}
+	return s, neg, len(s) == 0 || (s[0] != '-' && s[0] != '+')
+}
+
+// ToBig returns a big.Int version of z.
+// Return `nil` if z is nil
+func (z *Int256) ToBig() *big.Int {
+	if z == nil {
+		return nil
+	}
+	b := z.magnitude().ToBig()
+	if z.isNeg() {
+		b.Neg(b)
+	}
+	return b
+}
+
+// SetFromBig converts a big.Int to Int256 and sets the value to z.
+// It returns true if b does not fit in an Int256, in which case z is set to
+// the lower 256 bits of b in two's complement.
+func (z *Int256) SetFromBig(b *big.Int) bool {
+	overflow := z.u().SetFromBig(b)
+	// Within 256 bits, the sign only survives if b is in range
+	return overflow || z.isNeg() != (b.Sign() < 0)
+}
+
+// Int256FromBig is a convenience-constructor from big.Int.
+// Returns a new Int256 and whether overflow occurred.
+// OBS: If b is nil, this method returns 'nil, false'
+func Int256FromBig(b *big.Int) (*Int256, bool) {
+	if b == nil {
+		return nil, false
+	}
+	z := &Int256{}
+	overflow := z.SetFromBig(b)
+	return z, overflow
+}
+
+// MustInt256FromBig is a convenience-constructor from big.Int.
+// Returns a new Int256 and panics if overflow occurred.
+// OBS: If b is nil, this method does _not_ panic, but
+// instead returns nil
+func MustInt256FromBig(b *big.Int) *Int256 {
+	if b == nil {
+		return nil
+	}
+	z := &Int256{}
+	if z.SetFromBig(b) {
+		panic("overflow")
+	}
+	return z
+}
+
+// Dec returns the decimal representation of z, with a leading '-' if z is
+// negative.
+func (z *Int256) Dec() string {
+	if z.isNeg() {
+		return "-" + z.magnitude().Dec()
+	}
+	return z.u().Dec()
+}
+
+// Hex encodes z in 0x-prefixed hexadecimal form, with a leading '-' if z is
+// negative, e.g. "-0x1" for -1.
+func (z *Int256) Hex() string {
+	if z.isNeg() {
+		return "-" + z.magnitude().Hex()
+	}
+	return z.u().Hex()
+}
+
+// String returns the decimal encoding of z.
+func (z *Int256) String() string {
+	return z.Dec()
+}
+
+// Format implements fmt.Formatter, with the same formats as big.Int.
+func (z *Int256) Format(s fmt.State, ch rune) {
+	z.ToBig().Format(s, ch)
+}
+
+// SetFromDecimal sets z from the given string, interpreted as a decimal number,
+// with an optional leading '-' or '+'.
+// Returns ErrInt256Range if the number is outside the range of Int256.
+func (z *Int256) SetFromDecimal(s string) error {
+	var (
+		digits, neg, ok = splitSign(s)
+		magnitude       Int
+	)
+	if !ok {
+		// fromDecimal rejects the second sign
+		return magnitude.fromDecimal(digits)
+	}
+	if err := magnitude.SetFromDecimal(digits); err != nil {
+		return err
+	}
+	return z.setSigned(&magnitude, neg)
+}
+
+// SetFromHex sets z from the given string, interpreted as a hexadecimal
+// number, with an optional leading '-' or '+'. The number must be 0x-prefixed,
+// and follows the rules of (*Int).SetFromHex.
+// Returns ErrInt256Range if the number is outside the range of Int256.
+func (z *Int256) SetFromHex(hex string) error {
+	hex, neg, _ := splitSign(hex)
+	var magnitude Int
+	if err := magnitude.SetFromHex(hex); err != nil {
+		return err
+	}
+	return z.setSigned(&magnitude, neg)
+}
+
+// Int256FromDecimal is a convenience-constructor to create an Int256 from a
+// decimal (base 10) string, with an optional leading '-' or '+'.
+func Int256FromDecimal(decimal string) (*Int256, error) {
+	var z Int256
+	if err := z.SetFromDecimal(decimal); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// MustInt256FromDecimal is a convenience-constructor to create an Int256 from
+// a decimal (base 10) string.
+// Returns a new Int256 and panics if any error occurred.
+func MustInt256FromDecimal(decimal string) *Int256 {
+	z, err := Int256FromDecimal(decimal)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// Int256FromHex is a convenience-constructor to create an Int256 from a
+// hexadecimal string, with an optional leading '-' or '+'.
+func Int256FromHex(hex string) (*Int256, error) {
+	var z Int256
+	if err := z.SetFromHex(hex); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// MustInt256FromHex is a convenience-constructor to create an Int256 from a
+// hexadecimal string.
+// Returns a new Int256 and panics if any error occurred.
+func MustInt256FromHex(hex string) *Int256 {
+	z, err := Int256FromHex(hex)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// MarshalText implements encoding.TextMarshaler
+// MarshalText marshals using the signed decimal representation (compatible with big.Int)
+func (z *Int256) MarshalText() ([]byte, error) {
+	return []byte(z.Dec()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. This method
+// can unmarshal either hexadecimal or decimal, with an optional leading '-' or '+'.
+// - For hexadecimal, the input _must_ be prefixed with 0x or 0X
+func (z *Int256) UnmarshalText(input []byte) error {
+	s, _, _ := splitSign(string(input))
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return z.SetFromHex(string(input))
+	}
+	return z.SetFromDecimal(string(input))
+}
+
+// MarshalJSON implements json.Marshaler.
+// MarshalJSON marshals using the quoted signed decimal representation, like
+// (*Int).MarshalJSON.
+func (z *Int256) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + z.Dec() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. UnmarshalJSON accepts either
+// - Quoted string: either hexadecimal OR decimal
+// - Not quoted string: only decimal
+func (z *Int256) UnmarshalJSON(input []byte) error {
+	if len(input) < 2 || input[0] != '"' || input[len(input)-1] != '"' {
+		// if not quoted, it must be decimal
+		return z.SetFromDecimal(string(input))
+	}
+	return z.UnmarshalText(input[1 : len(input)-1])
+}
+
+// Scan implements the database/sql Scanner interface.
+// It decodes a signed decimal string, optionally in scientific notation, like
+// (*Int).Scan.
+func (dst *Int256) Scan(src any) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		dst.Clear()
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	case int64:
+		dst.SetInt64(src)
+		return nil
+	default:
+		return errors.New("unsupported type")
+	}
+	digits, neg, ok := splitSign(s)
+	var magnitude Int
+	if !ok {
+		// fromDecimal rejects the second sign
+		return magnitude.fromDecimal(digits)
+	}
+	if err := magnitude.scanScientificFromString(digits); err != nil {
+		return err
+	}
+	return dst.setSigned(&magnitude, neg)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+// It encodes a signed base 10 string, see (*Int).Value.
+func (src *Int256) Value() (driver.Value, error) {
+	return src.Dec(), nil
+}
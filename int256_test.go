@@ -0,0 +1,322 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+var (
+	bigMinInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	bigMaxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+)
+
+// int256TestValues returns the edge values, followed by random values of both signs.
+func int256TestValues(n int) []*Int256 {
+	values := []*Int256{
+		new(Int256), NewInt256(1), NewInt256(-1), NewInt256(2), NewInt256(-2),
+		MinInt256.Clone(), MaxInt256.Clone(),
+		new(Int256).Add(&MinInt256, NewInt256(1)),
+		new(Int256).Sub(&MaxInt256, NewInt256(1)),
+	}
+	for i := 0; i < n; i++ {
+		x := (*Int256)(randNum())
+		if i%2 == 0 {
+			x.Neg(x)
+		}
+		values = append(values, x)
+	}
+	return values
+}
+
+// inInt256Range reports whether b fits in an Int256.
+func inInt256Range(b *big.Int) bool {
+	return b.Cmp(bigMinInt256) >= 0 && b.Cmp(bigMaxInt256) <= 0
+}
+
+func TestInt256Arithmetic(t *testing.T) {
+	type overflowOp func(z, x, y *Int256) (*Int256, bool)
+	ops := []struct {
+		name  string
+		fn    overflowOp
+		bigFn func(z, x, y *big.Int) *big.Int
+	}{
+		{"AddOverflow", (*Int256).AddOverflow, (*big.Int).Add},
+		{"SubOverflow", (*Int256).SubOverflow, (*big.Int).Sub},
+		{"MulOverflow", (*Int256).MulOverflow, (*big.Int).Mul},
+		{"QuoOverflow", (*Int256).QuoOverflow, func(z, x, y *big.Int) *big.Int {
+			if y.Sign() == 0 {
+				return z.SetUint64(0)
+			}
+			return z.Quo(x, y)
+		}},
+		{"Rem", func(z, x, y *Int256) (*Int256, bool) {
+			return z.Rem(x, y), false
+		}, func(z, x, y *big.Int) *big.Int {
+			if y.Sign() == 0 {
+				return z.SetUint64(0)
+			}
+			return z.Rem(x, y)
+		}},
+	}
+	values := int256TestValues(150)
+	for _, x := range values {
+		for _, y := range values {
+			bx, by := x.ToBig(), y.ToBig()
+			for _, op := range ops {
+				var (
+					want         = op.bigFn(new(big.Int), bx, by)
+					wantOverflow = !inInt256Range(want)
+					wantValue    = (*Int256)(MustFromBig(new(big.Int).And(want, bigtt256m1)))
+					xc, yc       = *x, *y
+					have, of     = op.fn(new(Int256), &xc, &yc)
+				)
+				if !have.Eq(wantValue) || of != wantOverflow {
+					t.Fatalf("%v(%v, %v)\nwant : %v (overflow %v)\nhave : %v (overflow %v)", op.name, x, y, want, wantOverflow, have, of)
+				}
+				if !xc.Eq(x) || !yc.Eq(y) {
+					t.Fatalf("%v(%v, %v): arguments modified", op.name, x, y)
+				}
+				// Aliased result
+				if have, _ = op.fn(&xc, &xc, &yc); !have.Eq(wantValue) {
+					t.Fatalf("%v(%v, %v): aliased result %v, want %v", op.name, x, y, have, wantValue)
+				}
+			}
+			// The wrapping variants match the overflowing ones
+			wrapping := []struct {
+				name string
+				fn   func(z, x, y *Int256) *Int256
+				ofFn overflowOp
+			}{
+				{"Add", (*Int256).Add, (*Int256).AddOverflow},
+				{"Sub", (*Int256).Sub, (*Int256).SubOverflow},
+				{"Mul", (*Int256).Mul, (*Int256).MulOverflow},
+				{"Quo", (*Int256).Quo, (*Int256).QuoOverflow},
+			}
+			for _, op := range wrapping {
+				want, _ := op.ofFn(new(Int256), x, y)
+				if have := op.fn(new(Int256), x, y); !have.Eq(want) {
+					t.Fatalf("%v(%v, %v): have %v, want %v", op.name, x, y, have, want)
+				}
+			}
+			if have, want := x.Cmp(y), bx.Cmp(by); have != want {
+				t.Fatalf("Cmp(%v, %v): have %d, want %d", x, y, have, want)
+			}
+			if x.Lt(y) != (bx.Cmp(by) < 0) || x.Gt(y) != (bx.Cmp(by) > 0) || x.Eq(y) != (bx.Cmp(by) == 0) {
+				t.Fatalf("Lt/Gt/Eq(%v, %v) mismatch", x, y)
+			}
+		}
+	}
+}
+
+func TestInt256Unary(t *testing.T) {
+	for _, x := range int256TestValues(1000) {
+		bx := x.ToBig()
+		if have, want := x.Sign(), bx.Sign(); have != want {
+			t.Fatalf("Sign(%v): have %d, want %d", x, have, want)
+		}
+		if have, want := x.IsInt64(), bx.IsInt64(); have != want {
+			t.Fatalf("IsInt64(%v): have %v, want %v", x, have, want)
+		}
+		if x.IsInt64() && x.Int64() != bx.Int64() {
+			t.Fatalf("Int64(%v): have %d, want %d", x, x.Int64(), bx.Int64())
+		}
+		neg := new(big.Int).Neg(bx)
+		if !inInt256Range(neg) {
+			neg = bx // -MinInt256 wraps around
+		}
+		if have := new(Int256).Neg(x); have.ToBig().Cmp(neg) != 0 {
+			t.Fatalf("Neg(%v): have %v, want %v", x, have, neg)
+		}
+		abs := new(big.Int).Abs(bx)
+		if !inInt256Range(abs) {
+			abs = bx
+		}
+		if have := new(Int256).Abs(x); have.ToBig().Cmp(abs) != 0 {
+			t.Fatalf("Abs(%v): have %v, want %v", x, have, abs)
+		}
+	}
+	for _, v := range []int64{0, 1, -1, 1 << 62, -1 << 63, 1<<63 - 1} {
+		if have := NewInt256(v).ToBig(); have.Int64() != v || !have.IsInt64() {
+			t.Errorf("NewInt256(%d) = %v", v, have)
+		}
+	}
+}
+
+func TestInt256Text(t *testing.T) {
+	for _, x := range int256TestValues(1000) {
+		bx := x.ToBig()
+		if have, want := x.Dec(), bx.String(); have != want {
+			t.Fatalf("Dec(): have %v, want %v", have, want)
+		}
+		if have, want := x.String(), bx.String(); have != want {
+			t.Fatalf("String(): have %v, want %v", have, want)
+		}
+		if have, want := x.Hex(), fmt.Sprintf("%#x", bx); have != want {
+			t.Fatalf("Hex(): have %v, want %v", have, want)
+		}
+		if have, want := fmt.Sprintf("%+d", x), fmt.Sprintf("%+d", bx); have != want {
+			t.Fatalf("Format(): have %v, want %v", have, want)
+		}
+		if y, err := Int256FromDecimal(x.Dec()); err != nil || !y.Eq(x) {
+			t.Fatalf("Int256FromDecimal(%v): have %v, %v", x.Dec(), y, err)
+		}
+		if y, err := Int256FromHex(x.Hex()); err != nil || !y.Eq(x) {
+			t.Fatalf("Int256FromHex(%v): have %v, %v", x.Hex(), y, err)
+		}
+		// JSON round trip, and compatibility with big.Int in text form
+		data, err := json.Marshal(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := `"` + bx.String() + `"`; string(data) != want {
+			t.Fatalf("MarshalJSON(): have %s, want %s", data, want)
+		}
+		var y Int256
+		if err := json.Unmarshal(data, &y); err != nil || !y.Eq(x) {
+			t.Fatalf("UnmarshalJSON(%s): have %v, %v", data, &y, err)
+		}
+		if err := y.UnmarshalJSON([]byte(bx.String())); err != nil || !y.Eq(x) {
+			t.Fatalf("UnmarshalJSON(%s): have %v, %v", bx, &y, err)
+		}
+		if err := y.UnmarshalJSON([]byte(`"` + x.Hex() + `"`)); err != nil || !y.Eq(x) {
+			t.Fatalf("UnmarshalJSON(%s): have %v, %v", x.Hex(), &y, err)
+		}
+		text, _ := x.MarshalText()
+		var b big.Int
+		if err := b.UnmarshalText(text); err != nil || b.Cmp(bx) != 0 {
+			t.Fatalf("big.Int.UnmarshalText(%s): have %v, %v", text, &b, err)
+		}
+	}
+}
+
+func TestInt256TextErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   error
+	}{
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819968", ErrInt256Range},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819969", ErrInt256Range},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", ErrBig256Range},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", ErrInt256Range},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000001", ErrInt256Range},
+		{"-0x", ErrEmptyNumber},
+		{"0x01", ErrLeadingZero},
+		{"--1", nil},
+		{"-+1", nil},
+		{"+-0x1", nil},
+		{"+0x", ErrEmptyNumber},
+		{"", nil},
+		{"-", nil},
+		{"1-", nil},
+	} {
+		var z Int256
+		err := z.UnmarshalText([]byte(tc.input))
+		if err == nil {
+			t.Errorf("UnmarshalText(%q): expected error", tc.input)
+		} else if tc.err != nil && err != tc.err {
+			t.Errorf("UnmarshalText(%q): have error %v, want %v", tc.input, err, tc.err)
+		}
+	}
+	for _, tc := range []struct {
+		input string
+		want  *Int256
+	}{
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", &MinInt256},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", &MaxInt256},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000000", &MinInt256},
+		{"+12", NewInt256(12)},
+		{"-0", new(Int256)},
+		{"-0x1", NewInt256(-1)},
+		{"+0x1f", NewInt256(31)},
+	} {
+		var z Int256
+		if err := z.UnmarshalText([]byte(tc.input)); err != nil || !z.Eq(tc.want) {
+			t.Errorf("UnmarshalText(%q): have %v, %v, want %v", tc.input, &z, err, tc.want)
+		}
+	}
+	if z, err := Int256FromHex("+0x1f"); err != nil || !z.Eq(NewInt256(31)) {
+		t.Errorf("Int256FromHex(\"+0x1f\"): have %v, %v, want 31", z, err)
+	}
+	if _, err := Int256FromHex("+-0x1f"); err == nil {
+		t.Errorf("Int256FromHex(\"+-0x1f\"): expected error")
+	}
+}
+
+func TestInt256Big(t *testing.T) {
+	for _, x := range int256TestValues(1000) {
+		bx := x.ToBig()
+		if !inInt256Range(bx) {
+			t.Fatalf("ToBig(%v) out of range: %v", x.Hex(), bx)
+		}
+		if y, overflow := Int256FromBig(bx); overflow || !y.Eq(x) {
+			t.Fatalf("Int256FromBig(%v): have %v, %v", bx, y, overflow)
+		}
+		if y := MustInt256FromBig(bx); !y.Eq(x) {
+			t.Fatalf("MustInt256FromBig(%v): have %v", bx, y)
+		}
+	}
+	for _, b := range []*big.Int{
+		new(big.Int).Add(bigMaxInt256, big.NewInt(1)),
+		new(big.Int).Sub(bigMinInt256, big.NewInt(1)),
+		bigtt256,
+		new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 300)),
+		bigtt256m1,
+	} {
+		z, overflow := Int256FromBig(b)
+		if !overflow {
+			t.Errorf("Int256FromBig(%v): expected overflow", b)
+		}
+		if want := new(big.Int).And(b, bigtt256m1); z.u().ToBig().Cmp(want) != 0 {
+			t.Errorf("Int256FromBig(%v): have %v, want lower bits %v", b, z.u().Hex(), want)
+		}
+	}
+	if z, overflow := Int256FromBig(nil); z != nil || overflow {
+		t.Errorf("Int256FromBig(nil): have %v, %v", z, overflow)
+	}
+	if (*Int256)(nil).ToBig() != nil {
+		t.Error("ToBig of nil should be nil")
+	}
+}
+
+func TestInt256SQL(t *testing.T) {
+	for _, x := range int256TestValues(100) {
+		v, err := x.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var y Int256
+		if err := y.Scan(v); err != nil || !y.Eq(x) {
+			t.Fatalf("Scan(%v): have %v, %v", v, &y, err)
+		}
+		if err := y.Scan([]byte(v.(string))); err != nil || !y.Eq(x) {
+			t.Fatalf("Scan([]byte(%v)): have %v, %v", v, &y, err)
+		}
+	}
+	for _, tc := range []struct {
+		src  any
+		want *Int256
+	}{
+		{nil, new(Int256)},
+		{"", new(Int256)},
+		{"-12e3", NewInt256(-12000)},
+		{"+5e0", NewInt256(5)},
+		{int64(-42), NewInt256(-42)},
+	} {
+		y := NewInt256(7)
+		if err := y.Scan(tc.src); err != nil || !y.Eq(tc.want) {
+			t.Errorf("Scan(%v): have %v, %v, want %v", tc.src, y, err, tc.want)
+		}
+	}
+	for _, src := range []any{"--1", "-6e77", "1.5", 3.5} {
+		var y Int256
+		if err := y.Scan(src); err == nil {
+			t.Errorf("Scan(%v): expected error", src)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Command gen generates the fixed-width unsigned integer packages uint128,
+// uint192 and uint384 from the templates in the templates directory. The
+// templates follow the logic of the uint256 package, written for an arbitrary
+// number of 64-bit words.
+//
+// It is invoked through go generate from the repository root:
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// widths holds the bit widths of the generated packages.
+var widths = []int{128, 192, 384}
+
+// params are the values a template is executed with.
+type params struct {
+	Package    string // the package name, e.g. uint128
+	Bits       int    // the width of the integer in bits
+	Words      int    // the number of 64-bit words
+	Bytes      int    // the number of bytes
+	HexLen     int    // the length of the longest valid hex string, including the 0x prefix
+	MaxDecimal string // the decimal representation of 2^Bits-1
+	MaxExp10   int    // the largest e such that 10^e < 2^Bits
+}
+
+func newParams(bits int) params {
+	max := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	max.Sub(max, big.NewInt(1))
+	return params{
+		Package:    fmt.Sprintf("uint%d", bits),
+		Bits:       bits,
+		Words:      bits / 64,
+		Bytes:      bits / 8,
+		HexLen:     2 + bits/4,
+		MaxDecimal: max.String(),
+		MaxExp10:   len(max.String()) - 1,
+	}
+}
+
+// outputs maps each template to the name of the file it generates, where
+// {pkg} is substituted by the package name.
+var outputs = []struct {
+	template string
+	file     string
+}{
+	{"int.go.tmpl", "{pkg}.go"},
+	{"conversion.go.tmpl", "conversion.go"},
+	{"int_test.go.tmpl", "{pkg}_test.go"},
+}
+
+// generate renders all files of the package with the given parameters, and
+// returns them keyed by file name.
+func generate(tmpl *template.Template, p params) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, out := range outputs {
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, out.template, p); err != nil {
+			return nil, err
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", out.template, err)
+		}
+		files[strings.ReplaceAll(out.file, "{pkg}", p.Package)] = src
+	}
+	return files, nil
+}
+
+// funcs are the helper functions available to the templates.
+var funcs = template.FuncMap{
+	"add": func(a, b int) int { return a + b },
+	"sub": func(a, b int) int { return a - b },
+	"mul": func(a, b int) int { return a * b },
+	// seq returns the integers 1 through n.
+	"seq": func(n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = i + 1
+		}
+		return s
+	},
+}
+
+func parseTemplates() (*template.Template, error) {
+	return template.New("").Funcs(funcs).ParseFS(templates, "templates/*.tmpl")
+}
+
+func main() {
+	out := flag.String("out", ".", "directory to write the generated packages into")
+	flag.Parse()
+
+	tmpl, err := parseTemplates()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, bits := range widths {
+		p := newParams(bits)
+		files, err := generate(tmpl, p)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", p.Package, err)
+			os.Exit(1)
+		}
+		dir := filepath.Join(*out, p.Package)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		for name, src := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedUpToDate checks that the checked-in packages match the output
+// of the templates, i.e. that go generate has been run after the last edit.
+func TestGeneratedUpToDate(t *testing.T) {
+	tmpl, err := parseTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, bits := range widths {
+		p := newParams(bits)
+		files, err := generate(tmpl, p)
+		if err != nil {
+			t.Fatalf("%s: %v", p.Package, err)
+		}
+		for name, want := range files {
+			path := filepath.Join("..", "..", p.Package, name)
+			have, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(have, want) {
+				t.Errorf("%s is out of date, run go generate", path)
+			}
+		}
+	}
+}
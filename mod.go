@@ -11,13 +11,18 @@ import (
 // Reciprocal computes a 320-bit value representing 1/m
 //
 // Notes:
-// - specialized for m[3] != 0, hence limited to 2^192 <= m < 2^256
-// - returns zero if m[3] == 0
+// - if m[3] == 0, m is first normalized by shifting it left by whole words,
+//   and the result is the reciprocal of the normalized modulus
+// - returns zero if m == 0
 // - starts with a 32-bit division, refines with newton-raphson iterations
 func Reciprocal(m *Int) (mu [5]uint64) {
 
 	if m[3] == 0 {
-		return mu
+		if m.IsZero() {
+			return mu
+		}
+		n, _ := normalizeWords(m)
+		return Reciprocal(&n)
 	}
 
 	s := bits.LeadingZeros64(m[3]) // Replace with leadingZeros(m) for general case
@@ -328,6 +333,71 @@ func Reciprocal(m *Int) (mu [5]uint64) {
 	return mu
 }
 
+// normalizeWords returns m shifted left by whole words, so that n[3] != 0,
+// and the number of words shifted. m must not be zero.
+func normalizeWords(m *Int) (n Int, w uint) {
+	n = *m
+	for n[3] == 0 {
+		n[3], n[2], n[1], n[0] = n[2], n[1], n[0], 0
+		w++
+	}
+	return n, w
+}
+
+// reduce computes the least non-negative residue of x modulo m
+//
+// requires a nonzero modulus and its inverse (mu), as computed by Reciprocal
+func (z *Int) reduce(x *[8]uint64, m *Int, mu *[5]uint64) *Int {
+
+	if m[3] != 0 {
+		return z.reduce4(x, m, mu)
+	}
+
+	// For a one-word modulus, a chain of hardware divisions is faster
+
+	if m[2] | m[1] == 0 {
+		var rem uint64
+		for i := 7; i >= 0; i-- {
+			if rem | x[i] != 0 {
+				rem = bits.Rem64(rem, x[i], m[0])
+			}
+		}
+		return z.SetUint64(rem)
+	}
+
+	// With n = m * 2^(64w), x mod m = ((x * 2^(64w)) mod n) / 2^(64w).
+	// If x * 2^(64w) does not fit in 8 words, x is reduced modulo n first,
+	// which leaves the result unchanged:
+	//
+	//	(x * 2^(64w)) mod n = ((x mod n) * 2^(64w)) mod n
+
+	n, w := normalizeWords(m)
+
+	var y [8]uint64
+
+	top := uint64(0)
+	for i := 8 - w; i < 8; i++ {
+		top |= x[i]
+	}
+
+	if top == 0 {
+		copy(y[w:], x[:8-w])
+	} else {
+		var r Int
+		r.reduce4(x, &n, mu)
+		copy(y[w:], r[:])
+	}
+
+	var r Int
+	r.reduce4(&y, &n, mu)
+
+	// Shift the residue back down, the low w words are zero
+	z.Clear()
+	copy(z[:], r[w:])
+
+	return z
+}
+
 // reduce4 computes the least non-negative residue of x modulo m
 //
 // requires a four-word modulus (m[3] != 0) and its inverse (mu)
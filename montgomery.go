@@ -0,0 +1,207 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// ErrInvalidModulus is returned when a modulus is not odd or not greater than 1.
+var ErrInvalidModulus = errors.New("modulus must be odd and greater than 1")
+
+// Montgomery holds the precomputed values for arithmetic modulo a fixed odd
+// modulus m in Montgomery form, where x is represented by x*R mod m, with
+// R = 2^256.
+//
+// All values passed to and returned from the methods, except for ToMont and
+// FromMont, are in Montgomery form, and must be less than m. The methods can
+// be used concurrently, and the results may alias the arguments.
+type Montgomery struct {
+	m    Int
+	r    Int    // R mod m, the Montgomery form of 1
+	r2   Int    // R^2 mod m
+	r3   Int    // R^3 mod m
+	mInv uint64 // -m^-1 mod 2^64
+}
+
+// NewMontgomery returns a Montgomery context for the modulus m.
+// It returns ErrInvalidModulus if m is even or m <= 1.
+func NewMontgomery(m *Int) (*Montgomery, error) {
+	if m[0]&1 == 0 || m.isOne() {
+		return nil, ErrInvalidModulus
+	}
+	f := &Montgomery{
+		m:    *m,
+		mInv: negInverse64(m[0]),
+	}
+	// R mod m = (2^256 - m) mod m
+	f.r.Neg(m)
+	f.r.Mod(&f.r, m)
+	f.r2.MulMod(&f.r, &f.r, m)
+	f.r3.MulMod(&f.r2, &f.r, m)
+	return f, nil
+}
+
+// negInverse64 returns -x^-1 mod 2^64 for an odd x.
+func negInverse64(x uint64) uint64 {
+	inv := x // correct to 3 bits
+	// Each Newton iteration doubles the number of correct bits
+	for i := 0; i < 5; i++ {
+		inv *= 2 - x*inv
+	}
+	return -inv
+}
+
+// Modulus returns the modulus m.
+func (f *Montgomery) Modulus() *Int {
+	return f.m.Clone()
+}
+
+// One sets z to 1 in Montgomery form, and returns z.
+func (f *Montgomery) One(z *Int) *Int {
+	return z.Set(&f.r)
+}
+
+// ToMont sets z to x*R mod m, the Montgomery form of x, and returns z.
+// x may be any 256-bit value.
+func (f *Montgomery) ToMont(z, x *Int) *Int {
+	var t Int
+	if t.Set(x); !t.Lt(&f.m) {
+		t.Mod(&t, &f.m)
+	}
+	return f.Mul(z, &t, &f.r2)
+}
+
+// FromMont sets z to x*R^-1 mod m, the regular form of x, and returns z.
+func (f *Montgomery) FromMont(z, x *Int) *Int {
+	return f.Mul(z, x, &Int{1, 0, 0, 0})
+}
+
+// Mul sets z to x*y*R^-1 mod m, and returns z. It runs in constant time.
+func (f *Montgomery) Mul(z, x, y *Int) *Int {
+	// Coarsely integrated operand scanning (CIOS). The products of each
+	// row are independent, and are added in two carry chains: first the low
+	// halves, then the high halves one word up.
+	var (
+		m                      = &f.m
+		x0, x1, x2, x3         = x[0], x[1], x[2], x[3]
+		t0, t1, t2, t3, t4, t5 uint64
+		c                      uint64
+	)
+	for _, yi := range [4]uint64{y[0], y[1], y[2], y[3]} {
+		h0, l0 := bits.Mul64(x0, yi)
+		h1, l1 := bits.Mul64(x1, yi)
+		h2, l2 := bits.Mul64(x2, yi)
+		h3, l3 := bits.Mul64(x3, yi)
+		t0, c = bits.Add64(t0, l0, 0)
+		t1, c = bits.Add64(t1, l1, c)
+		t2, c = bits.Add64(t2, l2, c)
+		t3, c = bits.Add64(t3, l3, c)
+		t4, t5 = bits.Add64(t4, 0, c)
+		t1, c = bits.Add64(t1, h0, 0)
+		t2, c = bits.Add64(t2, h1, c)
+		t3, c = bits.Add64(t3, h2, c)
+		t4, c = bits.Add64(t4, h3, c)
+		t5 += c
+
+		// Add q*m, which clears t0, and shift down by one word
+		q := t0 * f.mInv
+		h0, l0 = bits.Mul64(q, m[0])
+		h1, l1 = bits.Mul64(q, m[1])
+		h2, l2 = bits.Mul64(q, m[2])
+		h3, l3 = bits.Mul64(q, m[3])
+		_, c = bits.Add64(t0, l0, 0)
+		t1, c = bits.Add64(t1, l1, c)
+		t2, c = bits.Add64(t2, l2, c)
+		t3, c = bits.Add64(t3, l3, c)
+		t4, c = bits.Add64(t4, 0, c)
+		t5 += c
+		t0, c = bits.Add64(t1, h0, 0)
+		t1, c = bits.Add64(t2, h1, c)
+		t2, c = bits.Add64(t3, h2, c)
+		t3, c = bits.Add64(t4, h3, c)
+		t4 = t5 + c
+		t5 = 0
+	}
+	// The result is less than 2m, subtract m once if needed. The choice is
+	// made with a mask rather than a branch, so that CTMulMod and CTExpMod
+	// run in constant time.
+	var (
+		r      Int
+		borrow uint64
+	)
+	r[0], borrow = bits.Sub64(t0, m[0], 0)
+	r[1], borrow = bits.Sub64(t1, m[1], borrow)
+	r[2], borrow = bits.Sub64(t2, m[2], borrow)
+	r[3], borrow = bits.Sub64(t3, m[3], borrow)
+	// t4 is 0 or 1, and t < m iff t4 == 0 and the subtraction borrowed
+	keep := -(borrow &^ t4)
+	z[0] = r[0]&^keep | t0&keep
+	z[1] = r[1]&^keep | t1&keep
+	z[2] = r[2]&^keep | t2&keep
+	z[3] = r[3]&^keep | t3&keep
+	return z
+}
+
+// Square sets z to x*x*R^-1 mod m, and returns z.
+func (f *Montgomery) Square(z, x *Int) *Int {
+	return f.Mul(z, x, x)
+}
+
+// Add sets z to x+y mod m, and returns z.
+func (f *Montgomery) Add(z, x, y *Int) *Int {
+	var (
+		r, s        Int
+		_, overflow = r.AddOverflow(x, y)
+		_, borrow   = s.SubOverflow(&r, &f.m)
+	)
+	if overflow || !borrow {
+		return z.Set(&s)
+	}
+	return z.Set(&r)
+}
+
+// Sub sets z to x-y mod m, and returns z.
+func (f *Montgomery) Sub(z, x, y *Int) *Int {
+	if _, borrow := z.SubOverflow(x, y); borrow {
+		z.Add(z, &f.m)
+	}
+	return z
+}
+
+// Neg sets z to -x mod m, and returns z.
+func (f *Montgomery) Neg(z, x *Int) *Int {
+	if x.IsZero() {
+		return z.Clear()
+	}
+	return z.Sub(&f.m, x)
+}
+
+// Inverse sets z to the multiplicative inverse of x, and returns z and true.
+// If x has no inverse, z is set to 0 and false is returned.
+func (f *Montgomery) Inverse(z, x *Int) (*Int, bool) {
+	// (x*R)^-1 = x^-1 * R^-1, which Mul with R^3 turns into x^-1 * R
+	if _, ok := z.ModInverse(x, &f.m); !ok {
+		return z, false
+	}
+	return f.Mul(z, z, &f.r3), true
+}
+
+// Exp sets z to x**exponent in Montgomery form, and returns z. The exponent
+// is a regular value, not in Montgomery form.
+func (f *Montgomery) Exp(z, x, exponent *Int) *Int {
+	var (
+		b   = *x
+		res = f.r
+	)
+	for i := exponent.BitLen() - 1; i >= 0; i-- {
+		f.Square(&res, &res)
+		if (exponent[i/64]>>uint(i%64))&1 == 1 {
+			f.Mul(&res, &res, &b)
+		}
+	}
+	return z.Set(&res)
+}
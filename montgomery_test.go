@@ -0,0 +1,92 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+var montgomeryModuli = []*Int{
+	// secp256k1 field prime
+	MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+	// BN254 base field prime
+	MustFromHex("0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47"),
+	// BLS12-381 scalar field prime
+	MustFromHex("0x73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001"),
+	MustFromHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+	MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000001"),
+	MustFromHex("0x10000000000000001"),
+	NewInt(0xffffffffffffffc5),
+	NewInt(3),
+}
+
+func TestNewMontgomery(t *testing.T) {
+	for _, m := range []*Int{new(Int), NewInt(1), NewInt(2), MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000000")} {
+		if f, err := NewMontgomery(m); f != nil || err != ErrInvalidModulus {
+			t.Errorf("NewMontgomery(%v): have %v, %v, want error", m.Hex(), f, err)
+		}
+	}
+	for _, m := range montgomeryModuli {
+		f, err := NewMontgomery(m)
+		if err != nil {
+			t.Fatalf("NewMontgomery(%v): %v", m.Hex(), err)
+		}
+		if !f.Modulus().Eq(m) {
+			t.Errorf("Modulus(): have %v, want %v", f.Modulus().Hex(), m.Hex())
+		}
+		if one := f.FromMont(new(Int), f.One(new(Int))); !one.Eq(NewInt(1)) {
+			t.Errorf("m=%v: FromMont(One()) = %v", m.Hex(), one.Hex())
+		}
+	}
+}
+
+func TestMontgomery(t *testing.T) {
+	for _, m := range montgomeryModuli {
+		f, _ := NewMontgomery(m)
+		bm := m.ToBig()
+		check := func(op string, have *Int, want *big.Int) {
+			t.Helper()
+			if !have.Eq(MustFromBig(want)) {
+				t.Fatalf("m=%v: %v\nwant : %#x\nhave : %#x", m.Hex(), op, want, have)
+			}
+		}
+		for i := 0; i < 2000; i++ {
+			var (
+				x, y   = randNum(), randNum()
+				bx, by = new(big.Int).Mod(x.ToBig(), bm), new(big.Int).Mod(y.ToBig(), bm)
+				mx, my = f.ToMont(new(Int), x), f.ToMont(new(Int), y)
+				z      Int
+			)
+			check("FromMont(ToMont(x))", f.FromMont(&z, mx), bx)
+
+			f.Mul(&z, mx, my)
+			check("Mul", f.FromMont(&z, &z), new(big.Int).Mod(new(big.Int).Mul(bx, by), bm))
+			check("Mul vs MulMod", f.FromMont(&z, f.Mul(&z, mx, my)), new(Int).MulMod(x, y, m).ToBig())
+			f.Square(&z, mx)
+			check("Square", f.FromMont(&z, &z), new(big.Int).Mod(new(big.Int).Mul(bx, bx), bm))
+			f.Add(&z, mx, my)
+			check("Add", f.FromMont(&z, &z), new(big.Int).Mod(new(big.Int).Add(bx, by), bm))
+			f.Sub(&z, mx, my)
+			check("Sub", f.FromMont(&z, &z), new(big.Int).Mod(new(big.Int).Sub(bx, by), bm))
+			f.Neg(&z, mx)
+			check("Neg", f.FromMont(&z, &z), new(big.Int).Mod(new(big.Int).Neg(bx), bm))
+
+			if _, ok := f.Inverse(&z, mx); ok {
+				check("Inverse", f.FromMont(&z, &z), new(big.Int).ModInverse(bx, bm))
+			} else if new(big.Int).ModInverse(bx, bm) != nil {
+				t.Fatalf("m=%v: Inverse(%v) failed", m.Hex(), x.Hex())
+			}
+			if i%10 == 0 {
+				f.Exp(&z, mx, y)
+				check("Exp", f.FromMont(&z, &z), new(big.Int).Exp(bx, y.ToBig(), bm))
+			}
+			// Aliasing
+			z.Set(mx)
+			f.Mul(&z, &z, &z)
+			check("Mul aliased", f.FromMont(&z, &z), new(big.Int).Mod(new(big.Int).Mul(bx, bx), bm))
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package poly
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/holiman/uint256"
+	"github.com/holiman/uint256/fields"
+)
+
+// ErrDomainSize is returned by NewDomain if the size is not a power of two
+// which divides p-1.
+var ErrDomainSize = errors.New("domain size is not a power of two dividing p-1")
+
+// Domain is an evaluation domain for the NTT: the n-th roots of unity of a
+// prime field, for n a power of two. A Domain can be used concurrently.
+type Domain struct {
+	f    *fields.Field
+	n    int
+	logN uint
+	w    uint256.Int // ω, a primitive n-th root of unity
+	nInv uint256.Int // n^-1 mod p
+
+	// The first n/2 powers of ω and of ω^-1, the twiddle factors of the
+	// butterflies
+	roots    []uint256.Int
+	invRoots []uint256.Int
+}
+
+// NewDomain returns the domain of size n over f. n must be a power of two no
+// larger than 2^f.TwoAdicity(); the BN254 scalar field allows up to 2^28,
+// and the BLS12-381 scalar field up to 2^32.
+func NewDomain(f *fields.Field, n int) (*Domain, error) {
+	if n <= 0 || n&(n-1) != 0 {
+		return nil, ErrDomainSize
+	}
+	logN := uint(bits.TrailingZeros(uint(n)))
+	if logN > f.TwoAdicity() {
+		return nil, ErrDomainSize
+	}
+	d := &Domain{
+		f:        f,
+		n:        n,
+		logN:     logN,
+		roots:    make([]uint256.Int, n/2),
+		invRoots: make([]uint256.Int, n/2),
+	}
+	// ω = rootOfUnity^(2^(s-logN)) has order exactly n.
+	w := &d.w
+	w.Set(f.RootOfUnity())
+	for i := logN; i < f.TwoAdicity(); i++ {
+		f.Square(w, w)
+	}
+	var wInv uint256.Int
+	f.Inverse(&wInv, w)
+	if n > 1 {
+		d.roots[0].SetOne()
+		d.invRoots[0].SetOne()
+	}
+	for i := 1; i < n/2; i++ {
+		f.Mul(&d.roots[i], &d.roots[i-1], w)
+		f.Mul(&d.invRoots[i], &d.invRoots[i-1], &wInv)
+	}
+	f.Inverse(&d.nInv, uint256.NewInt(uint64(n)))
+	return d, nil
+}
+
+// Size returns n, the number of points of the domain.
+func (d *Domain) Size() int {
+	return d.n
+}
+
+// Field returns the field of the domain.
+func (d *Domain) Field() *fields.Field {
+	return d.f
+}
+
+// Root returns ω, the primitive n-th root of unity of the domain. Coefficient
+// i of the NTT is the evaluation at ω^i.
+func (d *Domain) Root() *uint256.Int {
+	return d.w.Clone()
+}
+
+// NTT replaces the coefficients a of a polynomial by its evaluations at
+// ω^0, ..., ω^(n-1), in place. The coefficients must be reduced modulo p,
+// and len(a) must be n.
+func (d *Domain) NTT(a []uint256.Int) {
+	d.transform(a, d.roots)
+}
+
+// InverseNTT replaces the evaluations a at ω^0, ..., ω^(n-1) by the
+// coefficients of the polynomial, in place. It is the inverse of NTT.
+// len(a) must be n.
+func (d *Domain) InverseNTT(a []uint256.Int) {
+	d.transform(a, d.invRoots)
+	for i := range a {
+		d.f.Mul(&a[i], &a[i], &d.nInv)
+	}
+}
+
+// transform is the iterative radix-2 Cooley-Tukey transform: a bit-reversal
+// permutation followed by logN rounds of butterflies, with the twiddle
+// factors of roots.
+func (d *Domain) transform(a []uint256.Int, roots []uint256.Int) {
+	if len(a) != d.n {
+		panic("poly: NTT length differs from domain size")
+	}
+	if d.n == 1 {
+		return
+	}
+	shift := 64 - d.logN
+	for i := range a {
+		if j := int(bits.Reverse64(uint64(i)) >> shift); i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	f := d.f
+	var t uint256.Int
+	for size := 2; size <= d.n; size <<= 1 {
+		half, step := size/2, d.n/size
+		for start := 0; start < d.n; start += size {
+			for j := 0; j < half; j++ {
+				u, v := &a[start+j], &a[start+j+half]
+				f.Mul(&t, v, &roots[j*step])
+				f.Sub(v, u, &t)
+				f.Add(u, u, &t)
+			}
+		}
+	}
+}
+
+// Mul returns p * q mod the field prime, of length len(p)+len(q)-1, or nil if
+// either is empty. It multiplies pointwise in the NTT domain, which takes
+// O(n log n) instead of the O(n²) of the package-level Mul. The product must
+// fit in the domain: len(p)+len(q)-1 must not exceed n.
+func (d *Domain) Mul(p, q []uint256.Int) []uint256.Int {
+	if len(p) == 0 || len(q) == 0 {
+		return nil
+	}
+	if len(p)+len(q)-1 > d.n {
+		panic("poly: product does not fit in the domain")
+	}
+	a := make([]uint256.Int, d.n)
+	b := make([]uint256.Int, d.n)
+	for i := range p {
+		d.f.Reduce(&a[i], &p[i])
+	}
+	for i := range q {
+		d.f.Reduce(&b[i], &q[i])
+	}
+	d.NTT(a)
+	d.NTT(b)
+	for i := range a {
+		d.f.Mul(&a[i], &a[i], &b[i])
+	}
+	d.InverseNTT(a)
+	return a[:len(p)+len(q)-1]
+}
@@ -0,0 +1,111 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package poly
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/holiman/uint256/fields"
+)
+
+var nttFields = []*fields.Field{fields.BN254Scalar, fields.BLS12381Scalar, fields.Secp256k1Scalar}
+
+// bigDFT evaluates p at w^0, ..., w^(n-1) one point at a time
+func bigDFT(p []*big.Int, w, m *big.Int) []*big.Int {
+	r := make([]*big.Int, len(p))
+	wi := big.NewInt(1)
+	for i := range r {
+		r[i] = bigEval(p, wi, m)
+		wi = new(big.Int).Mul(wi, w)
+		wi.Mod(wi, m)
+	}
+	return r
+}
+
+func TestNTT(t *testing.T) {
+	rnd := rand.New(rand.NewSource(6))
+	for _, f := range nttFields {
+		mBig := f.Modulus().ToBig()
+		for logN := uint(0); logN <= 6 && logN <= f.TwoAdicity(); logN++ {
+			n := 1 << logN
+			d, err := NewDomain(f, n)
+			if err != nil {
+				t.Fatalf("%v: NewDomain(%d): %v", f, n, err)
+			}
+			// ω must have order exactly n
+			w := d.Root()
+			wBig := w.ToBig()
+			if new(big.Int).Exp(wBig, big.NewInt(int64(n)), mBig).Cmp(big.NewInt(1)) != 0 ||
+				(n > 1 && new(big.Int).Exp(wBig, big.NewInt(int64(n/2)), mBig).Cmp(big.NewInt(1)) == 0) {
+				t.Fatalf("%v: root %v does not have order %d", f, w, n)
+			}
+			p := randPoly(rnd, n, f.Modulus())
+			a := append([]uint256.Int{}, p...)
+			d.NTT(a)
+			checkPoly(t, "NTT", a, bigDFT(toBigs(p), wBig, mBig))
+			d.InverseNTT(a)
+			checkPoly(t, "InverseNTT", a, toBigs(p))
+		}
+	}
+}
+
+func TestDomainMul(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	for _, f := range nttFields {
+		m, mBig := f.Modulus(), f.Modulus().ToBig()
+		d, err := NewDomain(f, 64)
+		if err != nil {
+			t.Fatalf("%v: %v", f, err)
+		}
+		for i := 0; i < 20; i++ {
+			p := randPoly(rnd, 1+rnd.Intn(32), m)
+			q := randPoly(rnd, 1+rnd.Intn(65-len(p)), m)
+			checkPoly(t, "Domain.Mul", d.Mul(p, q), bigMul(toBigs(p), toBigs(q), mBig))
+		}
+	}
+}
+
+func TestNewDomainErrors(t *testing.T) {
+	for _, tc := range []struct {
+		f *fields.Field
+		n int
+	}{
+		{fields.BN254Scalar, 0},
+		{fields.BN254Scalar, -4},
+		{fields.BN254Scalar, 12},
+		{fields.Secp256k1Scalar, 128}, // 2-adicity 6
+		{fields.P256Base, 4},          // 2-adicity 1
+	} {
+		if _, err := NewDomain(tc.f, tc.n); err != ErrDomainSize {
+			t.Errorf("%v: NewDomain(%d) err = %v, want %v", tc.f, tc.n, err, ErrDomainSize)
+		}
+	}
+}
+
+func BenchmarkNTT(b *testing.B) {
+	rnd := rand.New(rand.NewSource(8))
+	d, _ := NewDomain(fields.BN254Scalar, 1024)
+	a := randPoly(rnd, 1024, fields.BN254Scalar.Modulus())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.NTT(a)
+	}
+}
+
+func BenchmarkDomainMul(b *testing.B) {
+	rnd := rand.New(rand.NewSource(9))
+	m := fields.BN254Scalar.Modulus()
+	d, _ := NewDomain(fields.BN254Scalar, 512)
+	p, q := randPoly(rnd, 256, m), randPoly(rnd, 256, m)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Mul(p, q)
+	}
+}
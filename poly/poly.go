@@ -0,0 +1,174 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package poly implements arithmetic on polynomials over prime fields below
+// 2^256: evaluation, Lagrange interpolation, addition, multiplication, and the
+// number-theoretic transform (NTT).
+//
+// A polynomial is a []uint256.Int of coefficients, lowest degree first, so
+// p[i] is the coefficient of x^i. The functions take the modulus m, and where
+// they multiply, its reciprocal mu as computed by uint256.Reciprocal. Results
+// are newly allocated, and are not trimmed of high zero coefficients.
+package poly
+
+import (
+	"errors"
+
+	"github.com/holiman/uint256"
+)
+
+// Errors returned by Interpolate
+var (
+	ErrDuplicatePoint = errors.New("interpolation points are not distinct")
+	ErrNotInvertible  = errors.New("interpolation point difference is not invertible")
+)
+
+// Eval sets z to p(x) mod m, and returns z. It uses Horner's method, one
+// multiplication and one addition per coefficient.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func Eval(z *uint256.Int, p []uint256.Int, x, m *uint256.Int, mu *[5]uint64) *uint256.Int {
+	var acc uint256.Int
+	for i := len(p) - 1; i >= 0; i-- {
+		acc.MulModWithReciprocal(&acc, x, m, mu)
+		acc.AddMod(&acc, &p[i], m)
+	}
+	return z.Set(&acc)
+}
+
+// Add returns p + q mod m, of the length of the longer of the two.
+func Add(p, q []uint256.Int, m *uint256.Int) []uint256.Int {
+	if len(p) < len(q) {
+		p, q = q, p
+	}
+	r := make([]uint256.Int, len(p))
+	for i := range p {
+		if i < len(q) {
+			r[i].AddMod(&p[i], &q[i], m)
+		} else {
+			r[i].Mod(&p[i], m)
+		}
+	}
+	return r
+}
+
+// Sub returns p - q mod m, of the length of the longer of the two.
+func Sub(p, q []uint256.Int, m *uint256.Int) []uint256.Int {
+	n := len(p)
+	if len(q) > n {
+		n = len(q)
+	}
+	var (
+		r    = make([]uint256.Int, n)
+		zero uint256.Int
+	)
+	for i := range r {
+		x, y := &zero, &zero
+		if i < len(p) {
+			x = &p[i]
+		}
+		if i < len(q) {
+			y = &q[i]
+		}
+		r[i].SubMod(x, y, m)
+	}
+	return r
+}
+
+// Mul returns p * q mod m, of length len(p)+len(q)-1, or nil if either is
+// empty. It is the schoolbook product: every coefficient is a dot product,
+// accumulated by ModDotProduct with a single reduction. For large operands
+// over an NTT-friendly field, Domain.Mul is faster.
+func Mul(p, q []uint256.Int, m *uint256.Int, mu *[5]uint64) []uint256.Int {
+	if len(p) == 0 || len(q) == 0 {
+		return nil
+	}
+	// With q reversed, the terms p[i]*q[k-i] of coefficient k are the dot
+	// product of contiguous ranges of p and rev.
+	rev := make([]uint256.Int, len(q))
+	for i := range q {
+		rev[len(q)-1-i] = q[i]
+	}
+	r := make([]uint256.Int, len(p)+len(q)-1)
+	for k := range r {
+		lo, hi := 0, k
+		if k >= len(q) {
+			lo = k - len(q) + 1
+		}
+		if hi >= len(p) {
+			hi = len(p) - 1
+		}
+		start := len(q) - 1 - k + lo
+		r[k].ModDotProduct(p[lo:hi+1], rev[start:start+hi-lo+1], m, mu)
+	}
+	return r
+}
+
+// Interpolate returns the polynomial of length len(xs) through the points
+// (xs[i], ys[i]), modulo the prime m. It computes the Lagrange form in O(n²):
+// the product M(x) of all (x - xs[i]), each basis polynomial M(x)/(x - xs[i])
+// by synthetic division, and the inverses of their denominators with a single
+// BatchModInverse.
+// It returns ErrDuplicatePoint if two xs are equal modulo m, and
+// ErrNotInvertible if a denominator has no inverse, which can only happen
+// if m is not prime. xs and ys must have the same length.
+func Interpolate(xs, ys []uint256.Int, m *uint256.Int, mu *[5]uint64) ([]uint256.Int, error) {
+	if len(xs) != len(ys) {
+		panic("poly: Interpolate slice lengths differ")
+	}
+	n := len(xs)
+	if n == 0 {
+		return nil, nil
+	}
+	x := make([]uint256.Int, n)
+	for i := range xs {
+		x[i].Mod(&xs[i], m)
+	}
+	// M(x) = (x - x[0])···(x - x[n-1]), monic of degree n
+	var (
+		full = make([]uint256.Int, n+1)
+		t    uint256.Int
+	)
+	full[0].SetOne()
+	for i := range x {
+		// Multiply by (x - x[i]), from the top down.
+		for j := i + 1; j > 0; j-- {
+			t.MulModWithReciprocal(&full[j], &x[i], m, mu)
+			full[j].SubMod(&full[j-1], &t, m)
+		}
+		full[0].MulModWithReciprocal(&full[0], &x[i], m, mu)
+		full[0].NegMod(&full[0], m)
+	}
+	// basis[i] = M(x)/(x - x[i]), and denom[i] = basis[i](x[i]), the
+	// product of all x[i] - x[j] with j != i.
+	var (
+		basis = make([][]uint256.Int, n)
+		denom = make([]uint256.Int, n)
+	)
+	for i := range x {
+		b := make([]uint256.Int, n)
+		b[n-1] = full[n]
+		for k := n - 1; k > 0; k-- {
+			t.MulModWithReciprocal(&x[i], &b[k], m, mu)
+			b[k-1].AddMod(&full[k], &t, m)
+		}
+		Eval(&denom[i], b, &x[i], m, mu)
+		if denom[i].IsZero() {
+			return nil, ErrDuplicatePoint
+		}
+		basis[i] = b
+	}
+	if !uint256.BatchModInverse(denom, denom, m, mu) {
+		return nil, ErrNotInvertible
+	}
+	r := make([]uint256.Int, n)
+	for i := range basis {
+		var c uint256.Int
+		c.MulModWithReciprocal(&ys[i], &denom[i], m, mu)
+		for k := range r {
+			t.MulModWithReciprocal(&c, &basis[i][k], m, mu)
+			r[k].AddMod(&r[k], &t, m)
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,231 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package poly
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/holiman/uint256/fields"
+)
+
+// testModuli are the moduli of the tests: 256-bit, 254-bit and single-word primes
+var testModuli = []*uint256.Int{
+	fields.Secp256k1Base.Modulus(),
+	fields.BN254Scalar.Modulus(),
+	uint256.NewInt(0xffffffff00000001),
+	uint256.NewInt(97),
+}
+
+func randPoly(rnd *rand.Rand, n int, m *uint256.Int) []uint256.Int {
+	p := make([]uint256.Int, n)
+	for i := range p {
+		for j := range p[i] {
+			p[i][j] = rnd.Uint64()
+		}
+		p[i].Mod(&p[i], m)
+	}
+	return p
+}
+
+func toBigs(p []uint256.Int) []*big.Int {
+	r := make([]*big.Int, len(p))
+	for i := range p {
+		r[i] = p[i].ToBig()
+	}
+	return r
+}
+
+func checkPoly(t *testing.T, name string, have []uint256.Int, want []*big.Int) {
+	t.Helper()
+	if len(have) != len(want) {
+		t.Fatalf("%s: length %d, want %d", name, len(have), len(want))
+	}
+	for i := range have {
+		if have[i].ToBig().Cmp(want[i]) != 0 {
+			t.Fatalf("%s: coefficient %d = %v, want %#x", name, i, &have[i], want[i])
+		}
+	}
+}
+
+func bigEval(p []*big.Int, x, m *big.Int) *big.Int {
+	r, xi := new(big.Int), big.NewInt(1)
+	for _, c := range p {
+		r.Add(r, new(big.Int).Mul(c, xi))
+		xi.Mul(xi, x).Mod(xi, m)
+	}
+	return r.Mod(r, m)
+}
+
+func bigMul(p, q []*big.Int, m *big.Int) []*big.Int {
+	r := make([]*big.Int, len(p)+len(q)-1)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	for i := range p {
+		for j := range q {
+			r[i+j].Add(r[i+j], new(big.Int).Mul(p[i], q[j]))
+		}
+	}
+	for i := range r {
+		r[i].Mod(r[i], m)
+	}
+	return r
+}
+
+// bigInterpolate is the textbook Lagrange sum of ys[i] * ∏ (x - xs[j]) / (xs[i] - xs[j])
+func bigInterpolate(xs, ys []*big.Int, m *big.Int) []*big.Int {
+	r := make([]*big.Int, len(xs))
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	for i := range xs {
+		basis, denom := []*big.Int{big.NewInt(1)}, big.NewInt(1)
+		for j := range xs {
+			if j == i {
+				continue
+			}
+			basis = bigMul(basis, []*big.Int{new(big.Int).Neg(xs[j]), big.NewInt(1)}, m)
+			denom.Mul(denom, new(big.Int).Sub(xs[i], xs[j])).Mod(denom, m)
+		}
+		c := new(big.Int).ModInverse(denom, m)
+		c.Mul(c, ys[i])
+		for k := range basis {
+			r[k].Add(r[k], new(big.Int).Mul(c, basis[k])).Mod(r[k], m)
+		}
+	}
+	return r
+}
+
+func TestEval(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range testModuli {
+		mu, mBig := uint256.Reciprocal(m), m.ToBig()
+		for n := 0; n < 20; n++ {
+			p := randPoly(rnd, n, m)
+			x := randPoly(rnd, 1, m)[0]
+			want := bigEval(toBigs(p), x.ToBig(), mBig)
+			if have := Eval(new(uint256.Int), p, &x, m, &mu); have.ToBig().Cmp(want) != 0 {
+				t.Fatalf("Eval(%v, %v) mod %v = %v, want %#x", p, &x, m, have, want)
+			}
+		}
+	}
+}
+
+func TestAddSubMul(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for _, m := range testModuli {
+		mu, mBig := uint256.Reciprocal(m), m.ToBig()
+		for i := 0; i < 50; i++ {
+			p, q := randPoly(rnd, rnd.Intn(20), m), randPoly(rnd, rnd.Intn(20), m)
+			pBig, qBig := toBigs(p), toBigs(q)
+			n := len(p)
+			if len(q) > n {
+				n = len(q)
+			}
+			sum, diff := make([]*big.Int, n), make([]*big.Int, n)
+			for k := 0; k < n; k++ {
+				sum[k], diff[k] = new(big.Int), new(big.Int)
+				if k < len(p) {
+					sum[k].Add(sum[k], pBig[k])
+					diff[k].Add(diff[k], pBig[k])
+				}
+				if k < len(q) {
+					sum[k].Add(sum[k], qBig[k])
+					diff[k].Sub(diff[k], qBig[k])
+				}
+				sum[k].Mod(sum[k], mBig)
+				diff[k].Mod(diff[k], mBig)
+			}
+			checkPoly(t, "Add", Add(p, q, m), sum)
+			checkPoly(t, "Sub", Sub(p, q, m), diff)
+			if len(p) == 0 || len(q) == 0 {
+				if r := Mul(p, q, m, &mu); r != nil {
+					t.Fatalf("Mul of empty = %v, want nil", r)
+				}
+				continue
+			}
+			checkPoly(t, "Mul", Mul(p, q, m, &mu), bigMul(pBig, qBig, mBig))
+		}
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for _, m := range testModuli {
+		mu, mBig := uint256.Reciprocal(m), m.ToBig()
+		for n := 0; n < 16; n++ {
+			xs, ys := randPoly(rnd, n, m), randPoly(rnd, n, m)
+			// Unreduced x values, which Interpolate reduces
+			if n > 0 && m[3] == 0 {
+				xs[0].Add(&xs[0], m)
+			}
+			r, err := Interpolate(xs, ys, m, &mu)
+			if err != nil {
+				// Only possible for the small modulus
+				if m[3] != 0 {
+					t.Fatalf("Interpolate mod %v: %v", m, err)
+				}
+				continue
+			}
+			xBig, yBig := toBigs(xs), toBigs(ys)
+			for i := range xBig {
+				xBig[i].Mod(xBig[i], mBig)
+			}
+			checkPoly(t, "Interpolate", r, bigInterpolate(xBig, yBig, mBig))
+			for i := range xs {
+				if have := Eval(new(uint256.Int), r, &xs[i], m, &mu); !have.Eq(&ys[i]) {
+					t.Fatalf("Interpolate: p(%v) = %v, want %v", &xs[i], have, &ys[i])
+				}
+			}
+		}
+	}
+}
+
+func TestInterpolateErrors(t *testing.T) {
+	m := fields.BN254Scalar.Modulus()
+	mu := uint256.Reciprocal(m)
+	xs := []uint256.Int{*uint256.NewInt(1), *uint256.NewInt(2), *new(uint256.Int).AddUint64(m, 1)}
+	ys := []uint256.Int{*uint256.NewInt(3), *uint256.NewInt(4), *uint256.NewInt(5)}
+	if _, err := Interpolate(xs, ys, m, &mu); err != ErrDuplicatePoint {
+		t.Errorf("duplicate point: err = %v, want %v", err, ErrDuplicatePoint)
+	}
+	// 15 - 5 = 10 has no inverse modulo 100
+	m = uint256.NewInt(100)
+	mu = uint256.Reciprocal(m)
+	xs = []uint256.Int{*uint256.NewInt(5), *uint256.NewInt(15)}
+	if _, err := Interpolate(xs, ys[:2], m, &mu); err != ErrNotInvertible {
+		t.Errorf("composite modulus: err = %v, want %v", err, ErrNotInvertible)
+	}
+	if r, err := Interpolate(nil, nil, m, &mu); r != nil || err != nil {
+		t.Errorf("empty: %v, %v", r, err)
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	rnd := rand.New(rand.NewSource(4))
+	m := fields.BN254Scalar.Modulus()
+	mu := uint256.Reciprocal(m)
+	p, q := randPoly(rnd, 256, m), randPoly(rnd, 256, m)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Mul(p, q, m, &mu)
+	}
+}
+
+func BenchmarkInterpolate(b *testing.B) {
+	rnd := rand.New(rand.NewSource(5))
+	m := fields.BN254Scalar.Modulus()
+	mu := uint256.Reciprocal(m)
+	xs, ys := randPoly(rnd, 64, m), randPoly(rnd, 64, m)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Interpolate(xs, ys, m, &mu)
+	}
+}
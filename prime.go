@@ -0,0 +1,348 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+const (
+	// primeBitMask has bit p set for every prime p < 64
+	primeBitMask = 1<<2 | 1<<3 | 1<<5 | 1<<7 | 1<<11 | 1<<13 | 1<<17 | 1<<19 |
+		1<<23 | 1<<29 | 1<<31 | 1<<37 | 1<<41 | 1<<43 | 1<<47 | 1<<53 | 1<<59 | 1<<61
+
+	// oddPrimesProduct is the product of the odd primes up to 53
+	oddPrimesProduct = 3 * 5 * 7 * 11 * 13 * 17 * 19 * 23 * 29 * 31 * 37 * 41 * 43 * 47 * 53
+
+	// maxNonResidue bounds the search for a quadratic non-residue in ModSqrt.
+	// Assuming the generalized Riemann hypothesis, the least non-residue of a
+	// prime p is below 2*ln(p)^2 < 62978.
+	maxNonResidue = 1 << 16
+)
+
+var (
+	// millerRabinBases are the first 13 primes. Together they are a proven
+	// deterministic set of Miller-Rabin bases for all n < millerRabinLimit.
+	millerRabinBases = [...]uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41}
+	// millerRabinLimit is 3317044064679887385961981 (Sorenson and Webster)
+	millerRabinLimit = Int{0x51adc5b22410a5fd, 0x2be69, 0, 0}
+)
+
+// remUint64 returns z mod d, for d != 0
+func (z *Int) remUint64(d uint64) uint64 {
+	var rem uint64
+	for i := 3; i >= 0; i-- {
+		rem = bits.Rem64(rem, z[i], d)
+	}
+	return rem
+}
+
+// Jacobi returns the Jacobi symbol (x/y), either +1, -1, or 0.
+// If y is even, the symbol is undefined and 0 is returned (OBS: differs from the big.Int)
+func Jacobi(x, y *Int) int {
+	if y[0]&1 == 0 {
+		return 0
+	}
+	var a, b, c Int
+	a.Mod(x, y)
+	b.Set(y)
+	j := 1
+	for {
+		if b.isOne() {
+			return j
+		}
+		if a.IsZero() {
+			return 0
+		}
+		a.Mod(&a, &b)
+		if a.IsZero() {
+			return 0
+		}
+		// (2/b) = -1 for b = 3 or 5 mod 8
+		s := uint(a.TrailingZeros())
+		if s&1 != 0 {
+			if bmod8 := b[0] & 7; bmod8 == 3 || bmod8 == 5 {
+				j = -j
+			}
+		}
+		c.Rsh(&a, s)
+		// Quadratic reciprocity: (c/b) = -(b/c) iff b = c = 3 mod 4
+		if b[0]&3 == 3 && c[0]&3 == 3 {
+			j = -j
+		}
+		a, b = b, c
+	}
+}
+
+// ModSqrt sets z to a square root of x mod p if such a square root exists,
+// and returns z and true. If x is not a square mod p, z is set to 0 and false
+// is returned.
+// The modulus p must be an odd prime, or 2. A square root modulo a composite p
+// may not be found, in which case false is returned as well.
+// If p == 0, z is set to 0 and false is returned (OBS: differs from the big.Int)
+func (z *Int) ModSqrt(x, p *Int) (*Int, bool) {
+	if p[0]&1 == 0 {
+		if p.CmpUint64(2) == 0 {
+			return z.SetUint64(x[0] & 1), true
+		}
+		return z.Clear(), false
+	}
+	if p.isOne() {
+		// Every x is a square mod 1, with root 0
+		return z.Clear(), true
+	}
+	var a Int
+	a.Mod(x, p)
+	switch Jacobi(&a, p) {
+	case -1:
+		return z.Clear(), false
+	case 0:
+		if a.IsZero() {
+			return z.Clear(), true
+		}
+		// p is not a prime
+		return z.Clear(), false
+	}
+	var (
+		mu = Reciprocal(p)
+		r  Int
+	)
+	if p[0]&3 == 3 {
+		// r = a^((p+1)/4), and (p+1)/4 = ⌊p/4⌋ + 1 does not overflow
+		var e Int
+		e.Rsh(p, 2)
+		e.AddUint64(&e, 1)
+		r.expModWithReciprocal(&a, &e, p, &mu)
+	} else if !r.tonelliShanks(&a, p, &mu) {
+		return z.Clear(), false
+	}
+	// For a prime p this always holds; it guards against composite moduli
+	var sq Int
+	if !sq.MulModWithReciprocal(&r, &r, p, &mu).Eq(&a) {
+		return z.Clear(), false
+	}
+	return z.Set(&r), true
+}
+
+// tonelliShanks sets z to a square root of the quadratic residue a modulo the
+// odd prime p, using the reciprocal mu of p, and reports whether it succeeded.
+// It follows section 6 of "Square roots from 1; 24, 51, 10 to Dan Shanks" by
+// Ezra Brown.
+func (z *Int) tonelliShanks(a, p *Int, mu *[5]uint64) bool {
+	// p-1 = s * 2^e, with s odd
+	var s Int
+	s.SubUint64(p, 1)
+	e := uint(s.TrailingZeros())
+	s.Rsh(&s, e)
+
+	// Find a non-residue n
+	var n Int
+	for n.SetUint64(2); Jacobi(&n, p) != -1; n[0]++ {
+		if n[0] == maxNonResidue {
+			return false
+		}
+	}
+	var y, b, g, t Int
+	y.AddUint64(&s, 1)
+	y.Rsh(&y, 1)
+	y.expModWithReciprocal(a, &y, p, mu)  // y = a^((s+1)/2)
+	b.expModWithReciprocal(a, &s, p, mu)  // b = a^s
+	g.expModWithReciprocal(&n, &s, p, mu) // g = n^s
+	r := e
+	for {
+		// Find the least m such that b^(2^m) = 1
+		var m uint
+		for t.Set(&b); !t.isOne(); m++ {
+			// m must end below r, which fails if a is not a square. For a
+			// composite p, t may also never reach 1.
+			if m+1 == r {
+				return false
+			}
+			t.MulModWithReciprocal(&t, &t, p, mu)
+		}
+		if m == 0 {
+			z.Set(&y)
+			return true
+		}
+		// t = g^(2^(r-m-1))
+		t.Set(&g)
+		for i := uint(0); i < r-m-1; i++ {
+			t.MulModWithReciprocal(&t, &t, p, mu)
+		}
+		g.MulModWithReciprocal(&t, &t, p, mu)
+		y.MulModWithReciprocal(&y, &t, p, mu)
+		b.MulModWithReciprocal(&b, &g, p, mu)
+		r = m
+	}
+}
+
+// ProbablyPrime reports whether z is probably prime, applying the Miller-Rabin
+// test with the first 13 prime bases, and n further pseudorandomly chosen
+// bases.
+//
+// For z < 3317044064679887385961981 the 13 fixed bases are proven sufficient,
+// and the result is exact. No such set of bases is known for the whole 256-bit
+// range, so for larger z the strong Lucas test is applied as well, which makes
+// this the Baillie-PSW test: no composite is known to pass it.
+//
+// The extra bases are derived from z, so the result for a given z never
+// changes. As with the big.Int, ProbablyPrime is not suitable for judging
+// primes that an adversary may have crafted to fool the test.
+func (z *Int) ProbablyPrime(n int) bool {
+	if n < 0 {
+		panic("uint256: negative n for ProbablyPrime")
+	}
+	if z.LtUint64(64) {
+		return primeBitMask&(1<<z[0]) != 0
+	}
+	if z[0]&1 == 0 {
+		return false // even
+	}
+	r := z.remUint64(oddPrimesProduct)
+	if r%3 == 0 || r%5 == 0 || r%7 == 0 || r%11 == 0 || r%13 == 0 || r%17 == 0 ||
+		r%19 == 0 || r%23 == 0 || r%29 == 0 || r%31 == 0 || r%37 == 0 || r%41 == 0 ||
+		r%43 == 0 || r%47 == 0 || r%53 == 0 {
+		return false
+	}
+	var (
+		mu  = Reciprocal(z)
+		nm1 Int
+		q   Int
+	)
+	nm1.SubUint64(z, 1)
+	k := uint(nm1.TrailingZeros())
+	q.Rsh(&nm1, k)
+	for _, a := range millerRabinBases {
+		if !z.millerRabin(&Int{a}, &nm1, &q, k, &mu) {
+			return false
+		}
+	}
+	if z.Lt(&millerRabinLimit) {
+		return true
+	}
+	// Extra bases in [2, z-2], from a xorshift generator seeded with z
+	var (
+		seed = z[0] ^ z[1] ^ z[2] ^ z[3]
+		nm3  Int
+	)
+	nm3.SubUint64(z, 3)
+	for i := 0; i < n; i++ {
+		var a Int
+		for j := range a {
+			seed ^= seed << 13
+			seed ^= seed >> 7
+			seed ^= seed << 17
+			a[j] = seed
+		}
+		a.Mod(&a, &nm3)
+		a.AddUint64(&a, 2)
+		if !z.millerRabin(&a, &nm1, &q, k, &mu) {
+			return false
+		}
+	}
+	return z.lucasTest(&mu)
+}
+
+// millerRabin reports whether the odd z passes the strong probable prime test
+// to base a < z, where z-1 = nm1 = q * 2^k with q odd, and mu is the
+// reciprocal of z.
+func (z *Int) millerRabin(a, nm1, q *Int, k uint, mu *[5]uint64) bool {
+	var y Int
+	y.expModWithReciprocal(a, q, z, mu)
+	if y.isOne() || y.Eq(nm1) {
+		return true
+	}
+	for i := uint(1); i < k; i++ {
+		y.MulModWithReciprocal(&y, &y, z, mu)
+		if y.Eq(nm1) {
+			return true
+		}
+		if y.isOne() {
+			return false
+		}
+	}
+	return false
+}
+
+// lucasTest reports whether the odd z, which is not divisible by the primes up
+// to 53, is an "almost extra strong" Lucas probable prime. It follows the
+// big.Int implementation: the parameters are chosen with Baillie's method C,
+// Q = 1 and the smallest P = 3, 4, 5, ... such that Jacobi(P²-4, z) = -1.
+func (z *Int) lucasTest(mu *[5]uint64) bool {
+	var p uint64
+	for p = 3; ; p++ {
+		if p > 10000 {
+			// This is only reachable for a perfect square, which is excluded
+			// at p = 40 below.
+			panic("uint256: internal error: cannot find (D/n) = -1 for " + z.Hex())
+		}
+		switch Jacobi(&Int{p*p - 4}, z) {
+		case -1:
+		case 0:
+			// D = (p-2)(p+2) shares the prime factor p+2 with z, as the
+			// smaller candidates were tried before. z > 53 is not prime.
+			return false
+		default:
+			if p == 40 && z.IsPerfectSquare() {
+				// Jacobi(D, z) = -1 never happens for a square
+				return false
+			}
+			continue
+		}
+		break
+	}
+	// s = (z+1) / 2^r, with s odd. z+1 does not overflow, as 2^256-1 is
+	// divisible by 3.
+	var s Int
+	s.AddUint64(z, 1)
+	r := uint(s.TrailingZeros())
+	s.Rsh(&s, r)
+
+	// Compute V(s) with the doubling formulas
+	//
+	//	V(2k) = V(k)² - 2
+	//	V(2k+1) = V(k) V(k+1) - P
+	var (
+		bigP = Int{p}
+		two  = Int{2}
+		vk   = Int{2}
+		vk1  = Int{p}
+	)
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		if s[i/64]>>(uint(i)%64)&1 != 0 {
+			vk.MulModWithReciprocal(&vk, &vk1, z, mu)
+			vk.SubMod(&vk, &bigP, z)
+			vk1.MulModWithReciprocal(&vk1, &vk1, z, mu)
+			vk1.SubMod(&vk1, &two, z)
+		} else {
+			vk1.MulModWithReciprocal(&vk, &vk1, z, mu)
+			vk1.SubMod(&vk1, &bigP, z)
+			vk.MulModWithReciprocal(&vk, &vk, z, mu)
+			vk.SubMod(&vk, &two, z)
+		}
+	}
+	// V(s) = ±2 and U(s) = 0, where U(s) = 0 iff P V(s) = 2 V(s+1)
+	var nm2 Int
+	nm2.SubUint64(z, 2)
+	if vk.Eq(&two) || vk.Eq(&nm2) {
+		var t1, t2 Int
+		t1.MulModWithReciprocal(&vk, &bigP, z, mu)
+		t2.DoubleMod(&vk1, z)
+		if t1.Eq(&t2) {
+			return true
+		}
+	}
+	// V(2^t s) = 0 for some 0 <= t < r-1
+	for t := uint(0); t+1 < r; t++ {
+		if vk.IsZero() {
+			return true
+		}
+		// 2 is a fixed point of V(2k) = V(k)² - 2
+		if vk.Eq(&two) {
+			return false
+		}
+		vk.MulModWithReciprocal(&vk, &vk, z, mu)
+		vk.SubMod(&vk, &two, z)
+	}
+	return false
+}
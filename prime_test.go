@@ -0,0 +1,178 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// testPrimes are odd primes of different sizes, both 1 and 3 mod 4
+var testPrimes = []*Int{
+	NewInt(3),
+	NewInt(17),
+	NewInt(65537),
+	NewInt(1000003),
+	MustFromHex("0xffffffff00000001"),
+	// 2^127-1
+	MustFromHex("0x7fffffffffffffffffffffffffffffff"),
+	// 2^255-19
+	MustFromHex("0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed"),
+	// secp256k1 field prime and group order
+	MustFromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+	MustFromHex("0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"),
+	// BN254 base field and scalar field
+	MustFromHex("0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47"),
+	MustFromHex("0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001"),
+	// P-256 field prime
+	MustFromHex("0xffffffff00000001000000000000000000000000ffffffffffffffffffffffff"),
+}
+
+func TestJacobi(t *testing.T) {
+	check := func(x, y *Int) {
+		t.Helper()
+		want := 0
+		if y[0]&1 == 1 {
+			want = big.Jacobi(x.ToBig(), y.ToBig())
+		}
+		if have := Jacobi(x, y); have != want {
+			t.Fatalf("Jacobi(%v, %v): have %d, want %d", x.Hex(), y.Hex(), have, want)
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		x, y := randNum(), randNum()
+		check(x, y)
+		y[0] |= 1
+		check(x, y)
+		check(x, new(Int).Rsh(y, uint(i%256)|1))
+	}
+	for _, p := range testPrimes {
+		check(new(Int), p)
+		check(p, p)
+		check(new(Int).SubUint64(p, 1), p)
+	}
+	check(NewInt(5), NewInt(1))
+	check(NewInt(5), new(Int))
+}
+
+func TestModSqrt(t *testing.T) {
+	check := func(x, p *Int) {
+		t.Helper()
+		var want *big.Int
+		if p.CmpUint64(2) == 0 {
+			// The big.Int panics for p = 2
+			want = big.NewInt(int64(x[0] & 1))
+		} else {
+			want = new(big.Int).ModSqrt(x.ToBig(), p.ToBig())
+		}
+		have, ok := new(Int).SetAllOne().ModSqrt(x, p)
+		if ok != (want != nil) {
+			t.Fatalf("ModSqrt(%v, %v): have ok=%v, want %v", x.Hex(), p.Hex(), ok, want)
+		}
+		if !ok {
+			if !have.IsZero() {
+				t.Fatalf("ModSqrt(%v, %v): have %v, want 0", x.Hex(), p.Hex(), have.Hex())
+			}
+			return
+		}
+		// Either root is fine
+		sq := new(Int).MulMod(have, have, p)
+		if !sq.Eq(new(Int).Mod(x, p)) || !have.Lt(p) {
+			t.Fatalf("ModSqrt(%v, %v): have %v, want ±%#x", x.Hex(), p.Hex(), have.Hex(), want)
+		}
+	}
+	for _, p := range append(testPrimes, NewInt(2)) {
+		for i := 0; i < 200; i++ {
+			x := randNum()
+			check(x, p)
+			// A square is always found
+			check(new(Int).MulMod(x, x, p), p)
+		}
+		check(new(Int), p)
+		check(p, p)
+	}
+	// Composite and degenerate moduli
+	for _, p := range []*Int{new(Int), NewInt(1), NewInt(4), NewInt(15), NewInt(9), NewInt(17 * 17), NewInt(5 * 13), NewInt(5 * 13 * 17)} {
+		for x := uint64(0); x < 300; x++ {
+			have, ok := new(Int).ModSqrt(NewInt(x), p)
+			if ok && !p.IsZero() && !new(Int).MulMod(have, have, p).Eq(new(Int).Mod(NewInt(x), p)) {
+				t.Fatalf("ModSqrt(%d, %v): have %v", x, p.Hex(), have.Hex())
+			}
+		}
+	}
+	// Every x is a square mod 1
+	for _, x := range []*Int{new(Int), NewInt(1), NewInt(5), new(Int).SetAllOne()} {
+		if have, ok := new(Int).SetAllOne().ModSqrt(x, NewInt(1)); !ok || !have.IsZero() {
+			t.Fatalf("ModSqrt(%v, 1): have %v, %v, want 0, true", x.Hex(), have.Hex(), ok)
+		}
+	}
+}
+
+func TestProbablyPrime(t *testing.T) {
+	check := func(x *Int) {
+		t.Helper()
+		want := x.ToBig().ProbablyPrime(20)
+		if have := x.ProbablyPrime(0); have != want {
+			t.Fatalf("ProbablyPrime(%v): have %v, want %v", x.Dec(), have, want)
+		}
+		if have := x.ProbablyPrime(10); have != want {
+			t.Fatalf("ProbablyPrime(%v, 10): have %v, want %v", x.Dec(), have, want)
+		}
+	}
+	for i := uint64(0); i < 2000; i++ {
+		check(NewInt(i))
+	}
+	for _, p := range testPrimes {
+		check(p)
+		check(new(Int).AddUint64(p, 2))
+		// Semiprimes
+		if p.BitLen() <= 128 {
+			check(new(Int).Mul(p, p))
+			check(new(Int).Mul(p, testPrimes[4]))
+		}
+	}
+	// Strong pseudoprimes to many prime bases
+	for _, s := range []string{
+		"3215031751",                    // 2, 3, 5, 7
+		"3825123056546413051",           // 2 to 23
+		"318665857834031151167461",      // 2 to 37
+		"3317044064679887385961981",     // 2 to 41
+		"6003094289670105800312596501",  // 2 to 61, > the proven limit
+		"59276361075595573263446330101", // 2 to 67
+		"564132928021909221014087501701",
+		"1543267864443420616877677640751301",
+	} {
+		check(MustFromDecimal(s))
+	}
+	// Random odd numbers, and the primes that follow them
+	for i := 0; i < 500; i++ {
+		x := randNum()
+		x[0] |= 1
+		check(x)
+		for !x.ToBig().ProbablyPrime(20) {
+			x.AddUint64(x, 2)
+		}
+		check(x)
+	}
+}
+
+func TestPrimeAllocs(t *testing.T) {
+	var (
+		p = testPrimes[len(testPrimes)-2] // Tonelli-Shanks
+		q = testPrimes[7]                 // p = 3 mod 4
+		x = randNum()
+		z Int
+	)
+	allocs := testing.AllocsPerRun(10, func() {
+		Jacobi(x, p)
+		z.ModSqrt(x, p)
+		z.ModSqrt(x, q)
+		p.ProbablyPrime(5)
+		x.ProbablyPrime(5)
+	})
+	if allocs != 0 {
+		t.Errorf("have %v allocations, want 0", allocs)
+	}
+}
@@ -0,0 +1,319 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// This file contains helpers for binary fixed-point numbers in Q format: an
+// Int x with frac fractional bits represents x / 2^frac. Uniswap, for example,
+// stores sqrt prices as Q64.96 (frac = 96) and fee growth as Q128.128
+// (frac = 128).
+
+const (
+	// MinTick is the smallest tick accepted by SetSqrtRatioAtTick.
+	MinTick = -887272
+	// MaxTick is the largest tick accepted by SetSqrtRatioAtTick.
+	MaxTick = 887272
+)
+
+var (
+	// MinSqrtRatio is the Q64.96 sqrt ratio at MinTick.
+	MinSqrtRatio = Int{4295128739, 0, 0, 0}
+	// MaxSqrtRatio is the Q64.96 sqrt ratio at MaxTick.
+	MaxSqrtRatio = Int{0x5d951d5263988d26, 0xefd1fc6a50648849, 0xfffd8963, 0}
+
+	ErrTickRange      = errors.New("tick out of range")
+	ErrSqrtRatioRange = errors.New("sqrt ratio out of range")
+)
+
+// MulShr sets z to the full 512-bit product x*y shifted right by n, rounded
+// down, and returns z and whether overflow occurred. On overflow, z is set to
+// the shifted product mod 2^256.
+func (z *Int) MulShr(x, y *Int, n uint) (*Int, bool) {
+	var p Uint512
+	p.MulFull(x, y)
+	p.Rsh(&p, n)
+	return z, z.SetFromUint512(&p)
+}
+
+// MulShrRound sets z to the full 512-bit product x*y shifted right by n, i.e.
+// x*y / 2^n, rounded according to mode, and returns z and whether overflow
+// occurred. On overflow, z is set to the rounded result mod 2^256.
+func (z *Int) MulShrRound(x, y *Int, n uint, mode RoundingMode) (*Int, bool) {
+	var p, q, rem Uint512
+	p.MulFull(x, y)
+	q.Rsh(&p, n)
+	rem.Sub(&p, rem.Lsh(&q, n))
+	if !rem.IsZero() {
+		// rem is non-zero, so n >= 1. Compare it against 2^(n-1).
+		half := -1
+		if n <= 512 {
+			var h Uint512
+			h.Lsh(&Uint512{1}, n-1)
+			half = rem.Cmp(&h)
+		}
+		if mode.roundUpHalf(half, q[0]&1 == 1) {
+			q.Add(&q, &Uint512{1})
+		}
+	}
+	return z, z.SetFromUint512(&q)
+}
+
+// SqrtQ sets z to the square root of x, where both x and z have frac
+// fractional bits, rounded down, and returns z. That is, z = ⌊√(x * 2^frac)⌋.
+// It panics if frac > 256.
+func (z *Int) SqrtQ(x *Int, frac uint) *Int {
+	if frac > 256 {
+		panic("uint256: Q format fractional bits out of range")
+	}
+	var n Uint512
+	n.SetInt(x)
+	n.Lsh(&n, frac)
+	return z.SqrtUint512(&n)
+}
+
+// Float64Q returns the float64 value of z with frac fractional bits.
+func (z *Int) Float64Q(frac uint) float64 {
+	if frac > math.MaxInt32 {
+		return 0
+	}
+	return math.Ldexp(z.Float64(), -int(frac))
+}
+
+// SetFloat64Q sets z to the value f with frac fractional bits, truncating
+// any lower bits, and returns true if f is negative, not finite or does not
+// fit. If f is too large, z is set to the result mod 2^256. If f is negative
+// or not finite, z is set to 0.
+func (z *Int) SetFloat64Q(f float64, frac uint) bool {
+	if f < 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+		z.Clear()
+		return true
+	}
+	if f == 0 || frac > math.MaxInt32 {
+		z.Clear()
+		return f != 0
+	}
+	// f = m * 2^exp exactly, with m having 53 bits
+	mant, exp := math.Frexp(f)
+	m := uint64(mant * (1 << 53))
+	shift := exp - 53 + int(frac)
+	z.SetUint64(m)
+	if shift >= 0 {
+		overflow := bits.Len64(m)+shift > 256
+		z.Lsh(z, uint(shift))
+		return overflow
+	}
+	z.Rsh(z, uint(-shift))
+	return false
+}
+
+// DecQ returns the decimal representation of z with frac fractional bits,
+// rounded to the given number of decimals according to mode, e.g.
+// "1.500000" for 3 << 95 with frac = 96 and 6 decimals.
+// It panics if decimals > MaxDecimals.
+func (z *Int) DecQ(frac uint, decimals uint8, mode RoundingMode) string {
+	if decimals == 0 {
+		// The last digit is in the integer part, which decides HalfEven.
+		var ip Int
+		ip.MulShrRound(z, &Int{1}, frac, mode)
+		return ip.Dec()
+	}
+	var ip, fp Int
+	if frac < 256 {
+		ip.Rsh(z, frac)
+		fp.Sub(z, new(Int).Lsh(&ip, frac))
+	} else {
+		fp.Set(z)
+	}
+	scale := pow10(decimals)
+	// fp < 2^frac, so this is at most 10^decimals and cannot overflow.
+	fp.MulShrRound(&fp, &scale, frac, mode)
+	if fp.Eq(&scale) {
+		// Rounded up into the integer part. If ip had all bits set, frac
+		// would be 0 and there would be nothing to round.
+		fp.Clear()
+		ip.AddUint64(&ip, 1)
+	}
+	return ip.Dec() + "." + padDecimals(fp.Dec(), int(decimals))
+}
+
+// SetFromDecimalQ sets z to the decimal string s, e.g. "1.5", with frac
+// fractional bits, rounded according to mode. The integer part is parsed as by
+// SetFromDecimal, and the fractional part as by (*Fixed).SetString, with as many
+// decimals as s has, up to MaxDecimals.
+// ErrBig256Range is returned if the value does not fit.
+// It panics if frac > 256.
+func (z *Int) SetFromDecimalQ(s string, frac uint, mode RoundingMode) error {
+	if frac > 256 {
+		panic("uint256: Q format fractional bits out of range")
+	}
+	intPart, fracPart := s, ""
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			intPart, fracPart = s[:i], s[i+1:]
+			if len(intPart) == 0 || intPart == "+" || len(fracPart) == 0 {
+				return ErrFixedSyntax
+			}
+			break
+		}
+	}
+	decimals := len(fracPart)
+	if decimals > MaxDecimals {
+		decimals = MaxDecimals
+	}
+	var (
+		ip Int
+		fp Fixed
+	)
+	if err := ip.SetFromDecimal(intPart); err != nil {
+		return err
+	}
+	if len(fracPart) != 0 {
+		if err := fp.SetString("0."+fracPart, uint8(decimals)); err != nil {
+			return err
+		}
+	}
+	if !ip.IsZero() && uint(ip.BitLen())+frac > 256 {
+		return ErrBig256Range
+	}
+	// The fractional part is less than 1, so its quotient is less than 2^frac,
+	// and the sum of the floor quotients fits.
+	var (
+		p     Uint512
+		q     Int
+		rem   Int
+		scale = pow10(uint8(decimals))
+	)
+	p.SetInt(&fp.raw)
+	p.Lsh(&p, frac)
+	p.DivMod(&p, &scale, &rem)
+	q.Lsh(&ip, frac)
+	q.Add(&q, p.Lo())
+	if mode.roundUp(&q, &rem, &scale) {
+		if _, overflow := q.AddOverflow(&q, &Int{1}); overflow {
+			return ErrBig256Range
+		}
+	}
+	z.Set(&q)
+	return nil
+}
+
+// tickRatios holds the Q128.128 values of 1/√1.0001^(2^i), from TickMath.
+var tickRatios = [20]Int{
+	{0xaa2d162d1a594001, 0xfffcb933bd6fad37},
+	{0x59a46990580e213a, 0xfff97272373d4132},
+	{0xef12357cf3c7fdcc, 0xfff2e50f5f656932},
+	{0x1c3624eaa0941cd0, 0xffe5caca7e10e4e6},
+	{0xc9db58835c926644, 0xffcb9843d60f6159},
+	{0x472e6896dfb254c0, 0xff973b41fa98c081},
+	{0x43ec78b326b52861, 0xff2ea16466c96a38},
+	{0x11c461f1969c3053, 0xfe5dee046a99a2a8},
+	{0xdcffc83b479aa3a4, 0xfcbe86c7900a88ae},
+	{0x6f2b074cf7815e54, 0xf987a7253ac41317},
+	{0x940c7a398e4b70f3, 0xf3392b0822b70005},
+	{0x43b29c7fa6e889d9, 0xe7159475a2c29b74},
+	{0x845ad8f792aa5825, 0xd097f3bdfd2022b8},
+	{0x8a65dc1f90e061e5, 0xa9f746462d870fdf},
+	{0x90bb3df62baf32f7, 0x70d869a156d2a1b8},
+	{0x81231505542fcfa6, 0x31be135f97d08fd9},
+	{0xc677de54f3e99bc9, 0x9aa508b5b7a84e1},
+	{0x6699c329225ee604, 0x5d6af8dedb8119},
+	{0x1ea926041bedfe98, 0x2216e584f5fa},
+	{0x91f7dc42444e8fa2, 0x48a1703},
+}
+
+// SetSqrtRatioAtTick sets z to the Q64.96 sqrt ratio √1.0001^tick, exactly as
+// getSqrtRatioAtTick of the Uniswap TickMath library computes it.
+// It returns ErrTickRange, and leaves z unchanged, if tick is outside of
+// [MinTick, MaxTick].
+func (z *Int) SetSqrtRatioAtTick(tick int32) error {
+	if tick < MinTick || tick > MaxTick {
+		return ErrTickRange
+	}
+	absTick := tick
+	if absTick < 0 {
+		absTick = -absTick
+	}
+	ratio := Int{0, 0, 1, 0} // 1 in Q128.128
+	if absTick&1 != 0 {
+		ratio = tickRatios[0]
+	}
+	for i := 1; i < len(tickRatios); i++ {
+		if absTick&(1<<i) != 0 {
+			ratio.MulShr(&ratio, &tickRatios[i], 128)
+		}
+	}
+	if tick > 0 {
+		ratio.Div(new(Int).SetAllOne(), &ratio)
+	}
+	// Back to Q64.96, rounding up, so that the inverse is exact.
+	rounded := ratio[0]&0xffffffff != 0
+	ratio.Rsh(&ratio, 32)
+	if rounded {
+		ratio.AddUint64(&ratio, 1)
+	}
+	z.Set(&ratio)
+	return nil
+}
+
+// TickAtSqrtRatio interprets z as a Q64.96 sqrt ratio, and returns the
+// greatest tick whose sqrt ratio is at most z, exactly as getTickAtSqrtRatio
+// of the Uniswap TickMath library computes it.
+// It returns ErrSqrtRatioRange if z is outside of [MinSqrtRatio, MaxSqrtRatio).
+func (z *Int) TickAtSqrtRatio() (int32, error) {
+	if z.Lt(&MinSqrtRatio) || !z.Lt(&MaxSqrtRatio) {
+		return 0, ErrSqrtRatioRange
+	}
+	var (
+		ratio = new(Int).Lsh(z, 32)
+		msb   = ratio.BitLen() - 1
+		r     Int
+	)
+	if msb >= 128 {
+		r.Rsh(ratio, uint(msb-127))
+	} else {
+		r.Lsh(ratio, uint(127-msb))
+	}
+	// log2 of the ratio in Q64.64, as a signed value: the integer part is
+	// msb - 128, and the fractional bits are computed by repeated squaring.
+	var log2 Int
+	if msb < 128 {
+		log2 = Int{0, uint64(msb - 128), ^uint64(0), ^uint64(0)}
+	} else {
+		log2 = Int{0, uint64(msb - 128), 0, 0}
+	}
+	for i := 63; i >= 50; i-- {
+		r.Mul(&r, &r)
+		r.Rsh(&r, 127)
+		f := r[2] & 1 // r >> 128, which is 0 or 1
+		log2[0] |= f << uint(i)
+		r.Rsh(&r, uint(f))
+	}
+	// log_√1.0001(ratio) in Q128.128, and the bounds of the error of the
+	// approximation of log2.
+	var (
+		logSqrt10001 = new(Int).Mul(&log2, &Int{0xa301d71055774c85, 0x3627})
+		tickLow      = new(Int).Sub(logSqrt10001, &Int{0x5af012a19d003aaa, 0x28f6481ab7f045a})
+		tickHigh     = new(Int).Add(logSqrt10001, &Int{0x455e260799a0632f, 0xdb2df09e81959a81})
+	)
+	tickLow.SRsh(tickLow, 128)
+	tickHigh.SRsh(tickHigh, 128)
+	var (
+		low  = int32(tickLow[0])
+		high = int32(tickHigh[0])
+	)
+	if low == high {
+		return low, nil
+	}
+	var atHigh Int
+	if err := atHigh.SetSqrtRatioAtTick(high); err == nil && !atHigh.Gt(z) {
+		return high, nil
+	}
+	return low, nil
+}
@@ -0,0 +1,269 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSqrtQ(t *testing.T) {
+	for i := 0; i < 2000; i++ {
+		var (
+			x    = randNum()
+			frac = uint(i % 257)
+			want = new(big.Int).Lsh(x.ToBig(), frac)
+		)
+		want.Sqrt(want)
+		if have := new(Int).SqrtQ(x, frac); have.ToBig().Cmp(want) != 0 {
+			t.Fatalf("SqrtQ(%v, %d)\nwant : %#x\nhave : %#x", x.Hex(), frac, want, have)
+		}
+	}
+	// 2.25 in Q64.96 is 1.5
+	if have, want := new(Int).SqrtQ(new(Int).Lsh(NewInt(9), 94), 96), new(Int).Lsh(NewInt(3), 95); !have.Eq(want) {
+		t.Errorf("SqrtQ(2.25): have %v, want %v", have.Hex(), want.Hex())
+	}
+	max := new(Int).SetAllOne()
+	if have, want := new(Int).SqrtQ(max, 256), max; !have.Eq(want) {
+		t.Errorf("SqrtQ(max, 256): have %v, want %v", have.Hex(), want.Hex())
+	}
+}
+
+func TestFloat64Q(t *testing.T) {
+	for _, tc := range []struct {
+		f        float64
+		frac     uint
+		want     *Int
+		overflow bool
+	}{
+		{0, 96, new(Int), false},
+		{1.5, 96, new(Int).Lsh(NewInt(3), 95), false},
+		{1.5, 0, NewInt(1), false},
+		{0.75, 1, NewInt(1), false},
+		{math.SmallestNonzeroFloat64, 1074, NewInt(1), false},
+		{math.Ldexp(1, 255), 0, new(Int).Lsh(NewInt(1), 255), false},
+		{math.Ldexp(1, 255), 1, new(Int), true},
+		{-1, 96, new(Int), true},
+		{math.NaN(), 96, new(Int), true},
+		{math.Inf(1), 96, new(Int), true},
+	} {
+		have := new(Int).SetAllOne()
+		if overflow := have.SetFloat64Q(tc.f, tc.frac); overflow != tc.overflow || !have.Eq(tc.want) {
+			t.Errorf("SetFloat64Q(%v, %d): have %v overflow %v, want %v overflow %v", tc.f, tc.frac, have.Hex(), overflow, tc.want.Hex(), tc.overflow)
+		}
+	}
+	// Values which are exact in float64 survive the round trip
+	for i := 0; i < 1000; i++ {
+		var (
+			frac = uint(i % 200)
+			x    = new(Int).Rsh(randNum(), 203) // 53 bits at most
+			z    Int
+		)
+		x.Lsh(x, uint(i%200))
+		f := x.Float64Q(frac)
+		if want := math.Ldexp(float64(new(Int).Rsh(x, uint(i%200)).Uint64()), i%200-int(frac)); f != want {
+			t.Fatalf("Float64Q(%v, %d): have %v, want %v", x.Hex(), frac, f, want)
+		}
+		if z.SetFloat64Q(f, frac); !z.Eq(x) {
+			t.Fatalf("SetFloat64Q(%v, %d): have %v, want %v", f, frac, z.Hex(), x.Hex())
+		}
+	}
+}
+
+func TestDecQ(t *testing.T) {
+	q96 := new(Int).Lsh(NewInt(1), 96)
+	for _, tc := range []struct {
+		x        *Int
+		frac     uint
+		decimals uint8
+		mode     RoundingMode
+		want     string
+	}{
+		{q96, 96, 6, Floor, "1.000000"},
+		{new(Int).Lsh(NewInt(3), 95), 96, 6, Floor, "1.500000"},
+		{new(Int).Lsh(NewInt(3), 95), 96, 0, HalfEven, "2"},
+		{new(Int).Lsh(NewInt(5), 95), 96, 0, HalfEven, "2"},
+		{new(Int).Lsh(NewInt(5), 95), 96, 0, HalfUp, "3"},
+		{new(Int).Sub(q96, NewInt(1)), 96, 6, Floor, "0.999999"},
+		{new(Int).Sub(q96, NewInt(1)), 96, 6, HalfUp, "1.000000"},
+		{NewInt(1), 256, 3, Ceil, "0.001"},
+		{new(Int).SetAllOne(), 0, 2, Floor, twoPow256Sub1 + ".00"},
+		{new(Int).SetAllOne(), 128, 0, Floor, "340282366920938463463374607431768211455"},
+	} {
+		if have := tc.x.DecQ(tc.frac, tc.decimals, tc.mode); have != tc.want {
+			t.Errorf("DecQ(%v, %d, %d, %v): have %s, want %s", tc.x.Hex(), tc.frac, tc.decimals, tc.mode, have, tc.want)
+		}
+	}
+	// Against big.Int, with the decimal point removed
+	for i := 0; i < 1000; i++ {
+		var (
+			x        = randNum()
+			frac     = uint(i % 260)
+			decimals = uint8(i % 40)
+			mode     = roundingModes[i%len(roundingModes)]
+			scaled   = new(big.Int).Mul(x.ToBig(), pow10Big(decimals))
+		)
+		bigDivRound(mode)(scaled, scaled, new(big.Int).Lsh(big.NewInt(1), frac))
+		want := padDecimals(scaled.String(), int(decimals)+1)
+		have := x.DecQ(frac, decimals, mode)
+		if decimals > 0 {
+			want = want[:len(want)-int(decimals)] + "." + want[len(want)-int(decimals):]
+		}
+		if have != want {
+			t.Fatalf("DecQ(%v, %d, %d, %v): have %s, want %s", x.Hex(), frac, decimals, mode, have, want)
+		}
+	}
+}
+
+func TestSetFromDecimalQ(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		frac uint
+		mode RoundingMode
+		want *Int
+		err  error
+	}{
+		{"1.5", 96, Floor, new(Int).Lsh(NewInt(3), 95), nil},
+		{"1", 128, Floor, new(Int).Lsh(NewInt(1), 128), nil},
+		{"0.1", 1, Floor, NewInt(0), nil},
+		{"0.1", 1, Ceil, NewInt(1), nil},
+		{"0.25", 1, HalfEven, NewInt(0), nil},
+		{"0.75", 1, HalfEven, NewInt(2), nil},
+		{"0.75", 1, HalfDown, NewInt(1), nil},
+		{"2", 255, Floor, nil, ErrBig256Range},
+		{"1.", 96, Floor, nil, ErrFixedSyntax},
+	} {
+		var z Int
+		err := z.SetFromDecimalQ(tc.s, tc.frac, tc.mode)
+		if err != tc.err {
+			t.Errorf("SetFromDecimalQ(%q, %d): have error %v, want %v", tc.s, tc.frac, err, tc.err)
+		} else if err == nil && !z.Eq(tc.want) {
+			t.Errorf("SetFromDecimalQ(%q, %d): have %v, want %v", tc.s, tc.frac, z.Hex(), tc.want.Hex())
+		}
+	}
+	// DecQ with enough decimals, and back
+	for i := 0; i < 1000; i++ {
+		var (
+			frac = uint(i % (MaxDecimals + 1))
+			x    = randNum()
+			z    Int
+		)
+		// 2^-frac has frac decimals
+		s := x.DecQ(frac, uint8(frac), Floor)
+		if err := z.SetFromDecimalQ(s, frac, Floor); err != nil || !z.Eq(x) {
+			t.Fatalf("SetFromDecimalQ(%q, %d): have %v (%v), want %v", s, frac, z.Hex(), err, x.Hex())
+		}
+	}
+}
+
+func TestSqrtRatioAtTick(t *testing.T) {
+	// Reference values of getSqrtRatioAtTick
+	for _, tc := range []struct {
+		tick int32
+		want string
+	}{
+		{MinTick, "4295128739"},
+		{MinTick + 1, "4295343490"},
+		{0, "79228162514264337593543950336"},
+		{MaxTick - 1, "1461373636630004318706518188784493106690254656249"},
+		{MaxTick, "1461446703485210103287273052203988822378723970342"},
+	} {
+		var z Int
+		if err := z.SetSqrtRatioAtTick(tc.tick); err != nil {
+			t.Fatal(err)
+		}
+		if have := z.Dec(); have != tc.want {
+			t.Errorf("tick %d: have %s, want %s", tc.tick, have, tc.want)
+		}
+	}
+	var z Int
+	if err := z.SetSqrtRatioAtTick(MinTick - 1); err != ErrTickRange {
+		t.Errorf("MinTick-1: have error %v", err)
+	}
+	if err := z.SetSqrtRatioAtTick(MaxTick + 1); err != ErrTickRange {
+		t.Errorf("MaxTick+1: have error %v", err)
+	}
+	// Against √1.0001^tick, in high precision
+	base, _ := new(big.Float).SetPrec(512).SetString("1.0001")
+	for i := 0; i < 200; i++ {
+		tick := int32(randNum()[0]%(2*MaxTick+1)) - MaxTick
+		if err := z.SetSqrtRatioAtTick(tick); err != nil {
+			t.Fatal(err)
+		}
+		want := bigFloatPowTick(base, tick)
+		have := new(big.Float).SetPrec(512).SetInt(z.ToBig())
+		diff, _ := new(big.Float).Quo(new(big.Float).Sub(have, want), want).Float64()
+		// The smallest ratios have 32 bits only
+		if math.Abs(diff) > 1e-9 {
+			t.Errorf("tick %d: have %v, want %v (relative error %v)", tick, have, want, diff)
+		}
+	}
+}
+
+// bigFloatPowTick returns √base^tick * 2^96
+func bigFloatPowTick(base *big.Float, tick int32) *big.Float {
+	var (
+		res = new(big.Float).SetPrec(512).SetInt64(1)
+		b   = new(big.Float).SetPrec(512).Sqrt(base)
+		n   = tick
+	)
+	if n < 0 {
+		n = -n
+		b.Quo(res, b)
+	}
+	for ; n != 0; n >>= 1 {
+		if n&1 != 0 {
+			res.Mul(res, b)
+		}
+		b.Mul(b, b)
+	}
+	return res.SetMantExp(res, 96)
+}
+
+func TestTickAtSqrtRatio(t *testing.T) {
+	var maxTickM1 Int
+	if err := maxTickM1.SetSqrtRatioAtTick(MaxTick - 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		ratio *Int
+		want  int32
+	}{
+		{&MinSqrtRatio, MinTick},
+		{new(Int).AddUint64(&MinSqrtRatio, 1), MinTick},
+		{new(Int).Lsh(NewInt(1), 96), 0},
+		{&maxTickM1, MaxTick - 1},
+		{new(Int).SubUint64(&MaxSqrtRatio, 1), MaxTick - 1},
+	} {
+		have, err := tc.ratio.TickAtSqrtRatio()
+		if err != nil || have != tc.want {
+			t.Errorf("TickAtSqrtRatio(%v): have %d (%v), want %d", tc.ratio.Dec(), have, err, tc.want)
+		}
+	}
+	if _, err := new(Int).SubUint64(&MinSqrtRatio, 1).TickAtSqrtRatio(); err != ErrSqrtRatioRange {
+		t.Errorf("MinSqrtRatio-1: have error %v", err)
+	}
+	if _, err := MaxSqrtRatio.TickAtSqrtRatio(); err != ErrSqrtRatioRange {
+		t.Errorf("MaxSqrtRatio: have error %v", err)
+	}
+	// The tick of the ratio at a tick is that tick, and the tick of the ratio
+	// just below it is the tick before.
+	for i := 0; i < 1000; i++ {
+		var (
+			tick  = int32(randNum()[0]%(2*MaxTick)) - MaxTick + 1
+			ratio Int
+		)
+		if err := ratio.SetSqrtRatioAtTick(tick); err != nil {
+			t.Fatal(err)
+		}
+		if have, err := ratio.TickAtSqrtRatio(); err != nil || have != tick {
+			t.Fatalf("tick %d: have %d (%v)", tick, have, err)
+		}
+		if have, err := ratio.SubUint64(&ratio, 1).TickAtSqrtRatio(); err != nil || have != tick-1 {
+			t.Fatalf("tick %d, ratio-1: have %d (%v), want %d", tick, have, err, tick-1)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrRandomRange is returned by RandomBelow if max is zero.
+var ErrRandomRange = errors.New("random range upper bound is zero")
+
+// RandomInt returns a uniformly random value in [0, 2^256), read from r.
+// It reads exactly 32 bytes, and returns the error if r fails.
+func RandomInt(r io.Reader) (*Int, error) {
+	var (
+		buf [32]byte
+		z   Int
+	)
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return nil, err
+	}
+	for i := range z {
+		z[i] = binary.LittleEndian.Uint64(buf[8*i:])
+	}
+	return &z, nil
+}
+
+// RandomBelow returns a uniformly random value in [0, max), read from r.
+// Candidates of max.BitLen() bits are drawn until one is below max, so there is
+// no modulo bias, and on average fewer than two draws are needed. Each draw
+// reads only the words of max that are non-zero.
+// If max == 0, ErrRandomRange is returned.
+func RandomBelow(r io.Reader, max *Int) (*Int, error) {
+	if max.IsZero() {
+		return nil, ErrRandomRange
+	}
+	var (
+		buf   [32]byte
+		z     Int
+		bl    = max.BitLen()
+		words = (bl + 63) / 64
+		mask  = ^uint64(0) >> (uint(64*words-bl) % 64)
+	)
+	for {
+		if _, err := io.ReadFull(r, buf[:8*words]); err != nil {
+			return nil, err
+		}
+		for i := 0; i < words; i++ {
+			z[i] = binary.LittleEndian.Uint64(buf[8*i:])
+		}
+		z[words-1] &= mask
+		if z.Lt(max) {
+			return &z, nil
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+//go:build go1.22
+
+package uint256
+
+import "math/rand/v2"
+
+// RandomIntFromSource returns a uniformly random value in [0, 2^256), drawing
+// one word at a time from src. With a seeded source such as rand.PCG this gives
+// fast, reproducible test data; it is not suitable for keys or nonces.
+func RandomIntFromSource(src rand.Source) *Int {
+	return &Int{src.Uint64(), src.Uint64(), src.Uint64(), src.Uint64()}
+}
+
+// RandomBelowFromSource returns a uniformly random value in [0, max), drawing
+// from src with the same rejection sampling as RandomBelow.
+// It panics if max == 0.
+func RandomBelowFromSource(src rand.Source, max *Int) *Int {
+	if max.IsZero() {
+		panic("uint256: RandomBelowFromSource max is zero")
+	}
+	var (
+		z     Int
+		bl    = max.BitLen()
+		words = (bl + 63) / 64
+		mask  = ^uint64(0) >> (uint(64*words-bl) % 64)
+	)
+	for {
+		for i := 0; i < words; i++ {
+			z[i] = src.Uint64()
+		}
+		z[words-1] &= mask
+		if z.Lt(max) {
+			return &z
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+//go:build go1.22
+
+package uint256
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRandomFromSource(t *testing.T) {
+	var (
+		src    = rand.NewPCG(1, 2)
+		counts [4][16]int
+	)
+	for i := 0; i < 32000; i++ {
+		for j, w := range RandomIntFromSource(src) {
+			counts[j][w>>60]++
+		}
+	}
+	for i := range counts {
+		if chi2 := chiSquare(counts[i][:]); chi2 > chiSquareLimit16 {
+			t.Errorf("word %d: chi-square %.1f > %.1f, counts %v", i, chi2, chiSquareLimit16, counts[i])
+		}
+	}
+	// The same seed gives the same values
+	a, b := RandomIntFromSource(rand.NewPCG(3, 4)), RandomIntFromSource(rand.NewPCG(3, 4))
+	if !a.Eq(b) {
+		t.Errorf("same seed, different values: %v and %v", a.Hex(), b.Hex())
+	}
+
+	max := new(Int).AddUint64(new(Int).Lsh(NewInt(1), 200), 12345)
+	var (
+		bucketCounts [16]int
+		bmax         = max.ToBig()
+		bucket       = new(big.Int)
+	)
+	for i := 0; i < 32000; i++ {
+		z := RandomBelowFromSource(src, max)
+		if !z.Lt(max) {
+			t.Fatalf("RandomBelowFromSource(%v): have %v", max.Hex(), z.Hex())
+		}
+		bucket.Div(bucket.Lsh(z.ToBig(), 4), bmax)
+		bucketCounts[bucket.Int64()]++
+	}
+	if chi2 := chiSquare(bucketCounts[:]); chi2 > chiSquareLimit16 {
+		t.Errorf("RandomBelowFromSource: chi-square %.1f > %.1f, counts %v", chi2, chiSquareLimit16, bucketCounts)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RandomBelowFromSource(0) did not panic")
+		}
+	}()
+	RandomBelowFromSource(src, new(Int))
+}
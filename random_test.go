@@ -0,0 +1,121 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	mrand "math/rand"
+	"testing"
+)
+
+// chiSquare returns the chi-square statistic of the bucket counts, against
+// the uniform distribution
+func chiSquare(counts []int) float64 {
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	var (
+		expected = float64(total) / float64(len(counts))
+		sum      float64
+	)
+	for _, c := range counts {
+		d := float64(c) - expected
+		sum += d * d / expected
+	}
+	return sum
+}
+
+// chiSquareLimit16 is the 99.999% quantile of the chi-square distribution
+// with 15 degrees of freedom
+const chiSquareLimit16 = 48.0
+
+func TestRandomInt(t *testing.T) {
+	var (
+		r = mrand.New(mrand.NewSource(1))
+		// The top and bottom nibble of every word
+		counts [8][16]int
+	)
+	for i := 0; i < 32000; i++ {
+		z, err := RandomInt(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j, w := range z {
+			counts[2*j][w>>60]++
+			counts[2*j+1][w&15]++
+		}
+	}
+	for i := range counts {
+		if chi2 := chiSquare(counts[i][:]); chi2 > chiSquareLimit16 {
+			t.Errorf("nibble %d: chi-square %.1f > %.1f, counts %v", i, chi2, chiSquareLimit16, counts[i])
+		}
+	}
+	// Exactly 32 bytes are read, and errors are passed on
+	src := bytes.NewReader(make([]byte, 40))
+	if z, err := RandomInt(src); err != nil || !z.IsZero() || src.Len() != 8 {
+		t.Errorf("RandomInt: have %v, %v, %d bytes left", z, err, src.Len())
+	}
+	if _, err := RandomInt(src); err != io.ErrUnexpectedEOF {
+		t.Errorf("RandomInt on short reader: have error %v", err)
+	}
+}
+
+func TestRandomBelow(t *testing.T) {
+	r := mrand.New(mrand.NewSource(2))
+	for _, max := range []*Int{
+		NewInt(16),
+		NewInt(17),
+		new(Int).AddUint64(new(Int).Lsh(NewInt(1), 64), 3),
+		// Rejects almost half of the candidates
+		new(Int).AddUint64(new(Int).Lsh(NewInt(1), 255), 1),
+		new(Int).Mul(NewInt(3), new(Int).Lsh(NewInt(1), 190)),
+		new(Int).SetAllOne(),
+	} {
+		var (
+			counts [16]int
+			bmax   = max.ToBig()
+			bucket = new(big.Int)
+		)
+		for i := 0; i < 32000; i++ {
+			z, err := RandomBelow(r, max)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !z.Lt(max) {
+				t.Fatalf("RandomBelow(%v): have %v", max.Hex(), z.Hex())
+			}
+			// Bucket ⌊16z/max⌋. For max = 17 this puts 0 and 1 together, and
+			// the expectation is corrected below.
+			bucket.Div(bucket.Lsh(z.ToBig(), 4), bmax)
+			counts[bucket.Int64()]++
+		}
+		if max.Eq(NewInt(17)) {
+			counts[0] = counts[0] / 2
+		}
+		if chi2 := chiSquare(counts[:]); chi2 > chiSquareLimit16 {
+			t.Errorf("RandomBelow(%v): chi-square %.1f > %.1f, counts %v", max.Hex(), chi2, chiSquareLimit16, counts)
+		}
+	}
+	// The smallest range
+	for i := 0; i < 100; i++ {
+		if z, err := RandomBelow(r, NewInt(1)); err != nil || !z.IsZero() {
+			t.Fatalf("RandomBelow(1): have %v, %v", z, err)
+		}
+	}
+	if _, err := RandomBelow(r, new(Int)); err != ErrRandomRange {
+		t.Errorf("RandomBelow(0): have error %v", err)
+	}
+	// Only the words needed for max are read
+	src := bytes.NewReader(make([]byte, 20))
+	if z, err := RandomBelow(src, NewInt(1000)); err != nil || !z.IsZero() || src.Len() != 12 {
+		t.Errorf("RandomBelow(1000): have %v, %v, %d bytes left", z, err, src.Len())
+	}
+	if _, err := RandomBelow(src, new(Int).Lsh(NewInt(1), 64)); err != io.ErrUnexpectedEOF {
+		t.Errorf("RandomBelow on short reader: have error %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+// SqrtRem sets z to ⌊√x⌋ and rem to x - z², and returns the pair (z, rem).
+func (z *Int) SqrtRem(x, rem *Int) (*Int, *Int) {
+	var s, sq Int
+	s.Sqrt(x)
+	sq.Mul(&s, &s)
+	rem.Sub(x, &sq)
+	return z.Set(&s), rem
+}
+
+// SqrtCeil sets z to ⌈√x⌉, the smallest integer such that z² ≥ x, and returns z.
+func (z *Int) SqrtCeil(x *Int) *Int {
+	var s, sq Int
+	s.Sqrt(x)
+	if !sq.Mul(&s, &s).Eq(x) {
+		// s < 2^128, so this does not overflow.
+		s.AddUint64(&s, 1)
+	}
+	return z.Set(&s)
+}
+
+// IsPerfectSquare reports whether z is the square of an integer.
+func (z *Int) IsPerfectSquare() bool {
+	// Squares are 0, 1, 4 or 9 mod 16.
+	if r := z[0] & 15; r != 0 && r != 1 && r != 4 && r != 9 {
+		return false
+	}
+	var s, sq Int
+	s.Sqrt(z)
+	return sq.Mul(&s, &s).Eq(z)
+}
+
+// SqrtUint512 sets z to ⌊√x⌋, the largest integer such that z² ≤ x, and
+// returns z. The root of a 512-bit value always fits in 256 bits.
+func (z *Int) SqrtUint512(x *Uint512) *Int {
+	if x[4]|x[5]|x[6]|x[7] == 0 {
+		return z.Sqrt(x.Lo())
+	}
+	// Newton's method as in Sqrt, but in 512 bits. Start with a value which is
+	// at least the root, and fits in 256 bits.
+	var r Int
+	if bl := uint(x.BitLen()); bl > 510 {
+		r.SetAllOne()
+	} else {
+		r.Lsh(&Int{1}, (bl+1)/2)
+	}
+	for {
+		var q, rq Uint512
+		q.Div(x, &r)
+		if q.Cmp(rq.SetInt(&r)) >= 0 {
+			return z.Set(&r)
+		}
+		// q < r, so (r + q) / 2 = q + (r - q) / 2 fits in 256 bits.
+		qi := q.Lo()
+		r.Sub(&r, qi)
+		r.Rsh(&r, 1)
+		r.Add(&r, qi)
+	}
+}
+
+// Cbrt sets z to ⌊∛x⌋, the largest integer such that z³ ≤ x, and returns z.
+func (z *Int) Cbrt(x *Int) *Int {
+	return z.Root(x, 3)
+}
+
+// Root sets z to the n-th root of x, rounded down, i.e. the largest integer
+// such that z^n ≤ x, and returns z.
+// If n == 0, z is set to 0
+func (z *Int) Root(x *Int, n uint) *Int {
+	switch {
+	case n == 0:
+		return z.Clear()
+	case n == 1:
+		return z.Set(x)
+	case n == 2:
+		return z.Sqrt(x)
+	case x.LtUint64(2):
+		return z.Set(x)
+	}
+	bl := uint(x.BitLen())
+	if n >= bl {
+		// 2 <= x < 2^n
+		return z.SetOne()
+	}
+	// Newton's method, starting with 2^⌈bl/n⌉, which is at least the root, and
+	// repeating r = ⌊((n-1)*r + ⌊x/r^(n-1)⌋) / n⌋ until it stops getting
+	// smaller. The root is below 2^86, so (n-1)*r does not overflow.
+	var (
+		xc  = *x // copy, z may alias x
+		r   Int
+		nm1 = Int{uint64(n - 1)}
+		nn  = Int{uint64(n)}
+	)
+	r.Lsh(&Int{1}, (bl+n-1)/n)
+	for {
+		var p, q, y Int
+		if _, overflow := p.expOverflow(&r, n-1); !overflow {
+			q.Div(&xc, &p)
+		}
+		y.Mul(&r, &nm1)
+		y.Add(&y, &q)
+		y.Div(&y, &nn)
+		if !y.Lt(&r) {
+			return z.Set(&r)
+		}
+		r = y
+	}
+}
+
+// expOverflow sets z = base**exponent, and returns z and whether overflow
+// occurred. On overflow, the value of z is unspecified.
+func (z *Int) expOverflow(base *Int, exponent uint) (*Int, bool) {
+	var (
+		res        = Int{1}
+		multiplier = *base
+	)
+	for exponent != 0 {
+		if exponent&1 != 0 {
+			if _, overflow := res.MulOverflow(&res, &multiplier); overflow {
+				return z, true
+			}
+		}
+		exponent >>= 1
+		if exponent == 0 {
+			break
+		}
+		if _, overflow := multiplier.MulOverflow(&multiplier, &multiplier); overflow {
+			return z, true
+		}
+	}
+	return z.Set(&res), false
+}
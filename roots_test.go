@@ -0,0 +1,131 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// bigSqrtCeil sets z to ⌈√x⌉
+func bigSqrtCeil(z, x *big.Int) *big.Int {
+	s := new(big.Int).Sqrt(x)
+	if new(big.Int).Mul(s, s).Cmp(x) != 0 {
+		s.Add(s, big.NewInt(1))
+	}
+	return z.Set(s)
+}
+
+// bigRoot sets z to the n-th root of x, rounded down, by binary search on the
+// bits of the root: returns 0 if n == 0
+func bigRoot(z, x *big.Int, n uint) *big.Int {
+	if n == 0 {
+		return z.SetUint64(0)
+	}
+	var (
+		r   = new(big.Int)
+		e   = new(big.Int).SetUint64(uint64(n))
+		pow = new(big.Int)
+	)
+	for i := x.BitLen()/int(n) + 1; i >= 0; i-- {
+		r.SetBit(r, i, 1)
+		if pow.Exp(r, e, nil).Cmp(x) > 0 {
+			r.SetBit(r, i, 0)
+		}
+	}
+	return z.Set(r)
+}
+
+func checkRoot(t *testing.T, x *Int, n uint) {
+	t.Helper()
+	want := bigRoot(new(big.Int), x.ToBig(), n)
+	if have := new(Int).Root(x, n); have.ToBig().Cmp(want) != 0 {
+		t.Fatalf("Root(%v, %d)\nwant : %#x\nhave : %#x", x.Hex(), n, want, have)
+	}
+}
+
+func TestRoot(t *testing.T) {
+	max := new(Int).SetAllOne()
+	values := []*Int{
+		new(Int), NewInt(1), NewInt(2), NewInt(7), NewInt(8), NewInt(9), NewInt(1 << 20),
+		max, new(Int).Lsh(NewInt(1), 255), new(Int).Rsh(max, 1),
+	}
+	for n := uint(0); n <= 260; n++ {
+		for _, x := range values {
+			checkRoot(t, x, n)
+		}
+		checkRoot(t, randNum(), n)
+	}
+	// Perfect powers and their neighbours
+	for _, n := range []uint{3, 4, 5, 7, 13} {
+		for i := 0; i < 100; i++ {
+			var (
+				r = new(Int).Rsh(randNum(), 256-256/n)
+				x = new(Int)
+			)
+			if _, overflow := x.expOverflow(r, n); overflow {
+				continue
+			}
+			checkRoot(t, x, n)
+			checkRoot(t, new(Int).AddUint64(x, 1), n)
+			checkRoot(t, new(Int).SubUint64(x, 1), n)
+		}
+	}
+}
+
+func TestSqrtUint512(t *testing.T) {
+	check := func(x *Uint512) {
+		t.Helper()
+		want := new(big.Int).Sqrt(x.ToBig())
+		if have := new(Int).SqrtUint512(x); have.ToBig().Cmp(want) != 0 {
+			t.Fatalf("SqrtUint512(%#x)\nwant : %#x\nhave : %#x", x.ToBig(), want, have)
+		}
+	}
+	max := new(Int).SetAllOne()
+	check(new(Uint512))
+	check(new(Uint512).SetHiLo(max, max))
+	check(new(Uint512).MulFull(max, max))
+	for i := 0; i < 1000; i++ {
+		var (
+			x = randNum()
+			p Uint512
+		)
+		p.MulFull(x, x)
+		check(&p)
+		check(p.Sub(&p, &Uint512{1}))
+		check(randUint512())
+	}
+}
+
+func FuzzRoots(f *testing.F) {
+	f.Fuzz(func(t *testing.T, x0, x1, x2, x3, x4, x5, x6, x7 uint64, n uint8) {
+		x := &Uint512{x0, x1, x2, x3, x4, x5, x6, x7}
+		want := new(big.Int).Sqrt(x.ToBig())
+		if have := new(Int).SqrtUint512(x); have.ToBig().Cmp(want) != 0 {
+			t.Fatalf("SqrtUint512(%#x)\nwant : %#x\nhave : %#x", x.ToBig(), want, have)
+		}
+		checkRoot(t, x.Lo(), uint(n))
+	})
+}
+
+func TestRootsAllocs(t *testing.T) {
+	var (
+		x   = randNum()
+		p   = randUint512()
+		z   Int
+		rem Int
+	)
+	allocs := testing.AllocsPerRun(10, func() {
+		z.SqrtRem(x, &rem)
+		z.SqrtCeil(x)
+		z.Cbrt(x)
+		z.Root(x, 7)
+		z.SqrtUint512(p)
+		x.IsPerfectSquare()
+	})
+	if allocs != 0 {
+		t.Errorf("have %v allocations, want 0", allocs)
+	}
+}
@@ -0,0 +1,106 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "strconv"
+
+// RoundingMode determines how the exact result of a division is rounded to
+// an integer.
+type RoundingMode uint8
+
+const (
+	// Floor rounds down, i.e. truncates. This is what Div does.
+	Floor RoundingMode = iota
+	// Ceil rounds up.
+	Ceil
+	// HalfUp rounds to the nearest integer, and halfway cases up.
+	HalfUp
+	// HalfEven rounds to the nearest integer, and halfway cases to the even
+	// one (banker's rounding).
+	HalfEven
+	// HalfDown rounds to the nearest integer, and halfway cases down.
+	HalfDown
+)
+
+// String returns the name of the rounding mode.
+func (mode RoundingMode) String() string {
+	switch mode {
+	case Floor:
+		return "Floor"
+	case Ceil:
+		return "Ceil"
+	case HalfUp:
+		return "HalfUp"
+	case HalfEven:
+		return "HalfEven"
+	case HalfDown:
+		return "HalfDown"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(mode)) + ")"
+}
+
+// roundUp reports whether the quotient q of a division by d, with the
+// remainder rem < d, is to be rounded up under the given mode.
+func (mode RoundingMode) roundUp(q, rem, d *Int) bool {
+	if rem.IsZero() {
+		return false
+	}
+	// Compare rem against d - rem, i.e. 2*rem against d, without overflowing.
+	var other Int
+	return mode.roundUpHalf(rem.Cmp(other.Sub(d, rem)), q[0]&1 == 1)
+}
+
+// roundUpHalf reports whether a quotient with a non-zero remainder is to be
+// rounded up under the given mode, where half is the result of comparing the
+// remainder against half the divisor, and odd tells whether the quotient is
+// odd.
+func (mode RoundingMode) roundUpHalf(half int, odd bool) bool {
+	switch mode {
+	case Ceil:
+		return true
+	case HalfUp:
+		return half >= 0
+	case HalfEven:
+		return half > 0 || (half == 0 && odd)
+	case HalfDown:
+		return half > 0
+	}
+	return false
+}
+
+// DivRound sets z to the quotient x/y, rounded according to mode, and returns z.
+// The rounded quotient always fits in 256 bits.
+// If y == 0, z is set to 0
+func (z *Int) DivRound(x, y *Int, mode RoundingMode) *Int {
+	var (
+		d   = *y // copy, z may alias y
+		rem Int
+	)
+	z.DivMod(x, &d, &rem)
+	if mode.roundUp(z, &rem, &d) {
+		z.AddUint64(z, 1)
+	}
+	return z
+}
+
+// MulDivRound calculates (x*y)/d with full precision, rounded according to
+// mode, and returns z and whether overflow occurred. The result overflows if
+// the rounded quotient does not fit in 256 bits, either because of the
+// multiplication or because rounding up pushes it past 2^256-1. On overflow,
+// z is set to the rounded quotient mod 2^256.
+// If d == 0, z is set to 0 and no overflow is reported.
+func (z *Int) MulDivRound(x, y, d *Int, mode RoundingMode) (*Int, bool) {
+	var (
+		dc  = *d // copy, z may alias d
+		rem Int
+	)
+	_, _, overflow := z.MulDivOverflowRem(x, y, &dc, &rem)
+	if mode.roundUp(z, &rem, &dc) {
+		if _, carry := z.AddOverflow(z, &Int{1}); carry {
+			overflow = true
+		}
+	}
+	return z, overflow
+}
@@ -0,0 +1,83 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDivRoundHalfway(t *testing.T) {
+	// Quotients of x/2, for each mode
+	for _, tc := range []struct {
+		x    uint64
+		want [5]uint64 // Floor, Ceil, HalfUp, HalfEven, HalfDown
+	}{
+		{4, [5]uint64{2, 2, 2, 2, 2}},
+		{5, [5]uint64{2, 3, 3, 2, 2}},
+		{7, [5]uint64{3, 4, 4, 4, 3}},
+	} {
+		for mode, want := range tc.want {
+			mode := RoundingMode(mode)
+			if have := new(Int).DivRound(NewInt(tc.x), NewInt(2), mode); !have.Eq(NewInt(want)) {
+				t.Errorf("DivRound(%d, 2, %v): have %v, want %d", tc.x, mode, have, want)
+			}
+		}
+	}
+}
+
+func TestMulDivRoundOverflow(t *testing.T) {
+	max := new(Int).SetAllOne()
+	// (2^256-1) * 3 / 3 fits exactly, and no rounding applies
+	if z, overflow := new(Int).MulDivRound(max, NewInt(3), NewInt(3), Ceil); overflow || !z.Eq(max) {
+		t.Errorf("exact: have %v, overflow %v", z.Hex(), overflow)
+	}
+	// (2^256-1) * 3 / 2 overflows in the multiplication already
+	if _, overflow := new(Int).MulDivRound(max, NewInt(3), NewInt(2), Floor); !overflow {
+		t.Errorf("expected overflow")
+	}
+	// (2^128+2) * (2^256-2^128+1) / (2^128+1) = 2^256-1 + 3/(2^128+1), which
+	// only overflows when rounded up
+	var (
+		x = MustFromHex("0x100000000000000000000000000000002")
+		y = MustFromHex("0xffffffffffffffffffffffffffffffff00000000000000000000000000000001")
+		d = MustFromHex("0x100000000000000000000000000000001")
+	)
+	for _, tc := range []struct {
+		mode     RoundingMode
+		overflow bool
+	}{{Floor, false}, {Ceil, true}, {HalfUp, false}, {HalfEven, false}, {HalfDown, false}} {
+		z, overflow := new(Int).MulDivRound(x, y, d, tc.mode)
+		if overflow != tc.overflow {
+			t.Errorf("%v: have overflow %v, want %v", tc.mode, overflow, tc.overflow)
+		}
+		want := max
+		if tc.overflow {
+			want = new(Int) // the rounded quotient 2^256 mod 2^256
+		}
+		if !z.Eq(want) {
+			t.Errorf("%v: have %v, want %v", tc.mode, z.Hex(), want.Hex())
+		}
+	}
+	// The overflow flag must match the big.Int result
+	for i := 0; i < 10000; i++ {
+		x, y, d := randNum(), randNum(), randNum()
+		for mode := Floor; mode <= HalfDown; mode++ {
+			_, overflow := new(Int).MulDivRound(x, y, d, mode)
+			want := bigDivRound(mode)(new(big.Int), new(big.Int).Mul(x.ToBig(), y.ToBig()), d.ToBig())
+			if wantOverflow := want.Cmp(bigtt256m1) > 0; overflow != wantOverflow {
+				t.Fatalf("MulDivRound(%v, %v, %v, %v): have overflow %v, want %v", x.Hex(), y.Hex(), d.Hex(), mode, overflow, wantOverflow)
+			}
+		}
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	for mode, want := range []string{"Floor", "Ceil", "HalfUp", "HalfEven", "HalfDown", "RoundingMode(5)"} {
+		if have := RoundingMode(mode).String(); have != want {
+			t.Errorf("have %q, want %q", have, want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+// AddSat sets z to the sum x+y, clamped to 2^256-1 on overflow, and returns z.
+func (z *Int) AddSat(x, y *Int) *Int {
+	if _, overflow := z.AddOverflow(x, y); overflow {
+		return z.SetAllOne()
+	}
+	return z
+}
+
+// AddUint64Sat sets z to the sum x+y, where y is a uint64, clamped to
+// 2^256-1 on overflow, and returns z.
+func (z *Int) AddUint64Sat(x *Int, y uint64) *Int {
+	return z.AddSat(x, &Int{y})
+}
+
+// SubSat sets z to the difference x-y, clamped to 0 on underflow, and returns z.
+func (z *Int) SubSat(x, y *Int) *Int {
+	if _, overflow := z.SubOverflow(x, y); overflow {
+		return z.Clear()
+	}
+	return z
+}
+
+// SubUint64Sat sets z to the difference x-y, where y is a uint64, clamped to
+// 0 on underflow, and returns z.
+func (z *Int) SubUint64Sat(x *Int, y uint64) *Int {
+	return z.SubSat(x, &Int{y})
+}
+
+// MulSat sets z to the product x*y, clamped to 2^256-1 on overflow, and returns z.
+func (z *Int) MulSat(x, y *Int) *Int {
+	if _, overflow := z.MulOverflow(x, y); overflow {
+		return z.SetAllOne()
+	}
+	return z
+}
+
+// LshSat sets z = x << n, clamped to 2^256-1 if any set bit is shifted out,
+// and returns z.
+func (z *Int) LshSat(x *Int, n uint) *Int {
+	if x.IsZero() {
+		return z.Clear()
+	}
+	if n >= 256 || uint(x.BitLen())+n > 256 {
+		return z.SetAllOne()
+	}
+	return z.Lsh(x, n)
+}
+
+// ExpSat sets z = base**exponent, clamped to 2^256-1 on overflow, and returns z.
+func (z *Int) ExpSat(base, exponent *Int) *Int {
+	var (
+		res        = Int{1, 0, 0, 0}
+		multiplier = *base
+		expBitLen  = exponent.BitLen()
+	)
+	for i := 0; i < expBitLen; i++ {
+		if (exponent[i/64]>>uint(i%64))&1 != 0 {
+			if _, overflow := res.MulOverflow(&res, &multiplier); overflow {
+				return z.SetAllOne()
+			}
+		}
+		if i+1 == expBitLen {
+			break
+		}
+		// The top bit of the exponent is set, so an overflowing multiplier
+		// makes the result overflow too.
+		if _, overflow := multiplier.MulOverflow(&multiplier, &multiplier); overflow {
+			return z.SetAllOne()
+		}
+	}
+	return z.Set(&res)
+}
+
+// setSignedSat sets z to the limit of the signed range in the direction of
+// neg: -2^255 if neg is true, 2^255-1 otherwise.
+func (z *Int) setSignedSat(neg bool) *Int {
+	if neg {
+		return z.Set((*Int)(&MinInt256))
+	}
+	return z.Set((*Int)(&MaxInt256))
+}
+
+// SAddSat interprets x and y as two's complement signed integers, sets z to
+// the sum x+y, clamped to [-2^255, 2^255-1], and returns z.
+func (z *Int) SAddSat(x, y *Int) *Int {
+	var sum Int
+	sum.Add(x, y)
+	// Adding a non-negative y must not decrease x, and adding a negative y
+	// must not increase it.
+	if yNeg := y.isNeg(); (!yNeg && sum.Slt(x)) || (yNeg && sum.Sgt(x)) {
+		return z.setSignedSat(yNeg)
+	}
+	return z.Set(&sum)
+}
+
+// SSubSat interprets x and y as two's complement signed integers, sets z to
+// the difference x-y, clamped to [-2^255, 2^255-1], and returns z.
+func (z *Int) SSubSat(x, y *Int) *Int {
+	var diff Int
+	diff.Sub(x, y)
+	// Subtracting a non-negative y must not increase x, and subtracting a
+	// negative y must not decrease it.
+	if yNeg := y.isNeg(); (!yNeg && diff.Sgt(x)) || (yNeg && diff.Slt(x)) {
+		return z.setSignedSat(!yNeg)
+	}
+	return z.Set(&diff)
+}
+
+// SMulSat interprets x and y as two's complement signed integers, sets z to
+// the product x*y, clamped to [-2^255, 2^255-1], and returns z.
+func (z *Int) SMulSat(x, y *Int) *Int {
+	if x.IsZero() || y.IsZero() {
+		return z.Clear()
+	}
+	var (
+		neg  = x.isNeg() != y.isNeg()
+		a, b Int
+	)
+	// The magnitudes are interpreted as unsigned, so |-2^255| = 2^255 is exact.
+	a.Abs(x)
+	b.Abs(y)
+	if _, overflow := z.MulOverflow(&a, &b); overflow {
+		return z.setSignedSat(neg)
+	}
+	return z.applySignSat(neg)
+}
+
+// SLshSat interprets x as a two's complement signed integer, sets z = x << n,
+// clamped to [-2^255, 2^255-1] if the shift changes the value of any bit
+// shifted out or the sign, and returns z.
+func (z *Int) SLshSat(x *Int, n uint) *Int {
+	if x.IsZero() {
+		return z.Clear()
+	}
+	neg := x.isNeg()
+	if n >= 256 {
+		return z.setSignedSat(neg)
+	}
+	var shifted, back Int
+	shifted.Lsh(x, n)
+	if !back.SRsh(&shifted, n).Eq(x) {
+		return z.setSignedSat(neg)
+	}
+	return z.Set(&shifted)
+}
+
+// SExpSat interprets base as a two's complement signed integer, sets
+// z = base**exponent, clamped to [-2^255, 2^255-1], and returns z.
+// The exponent is interpreted as unsigned.
+func (z *Int) SExpSat(base, exponent *Int) *Int {
+	neg := base.isNeg() && exponent[0]&1 == 1
+	var abs Int
+	abs.Abs(base)
+	z.ExpSat(&abs, exponent)
+	return z.applySignSat(neg)
+}
+
+// applySignSat interprets z as an unsigned magnitude, and sets z to the
+// signed value with that magnitude and the sign given by neg, clamped to
+// [-2^255, 2^255-1].
+func (z *Int) applySignSat(neg bool) *Int {
+	if neg {
+		// The magnitude of -2^255 is 2^255, which has the sign bit set alone.
+		if z.Gt((*Int)(&MinInt256)) {
+			return z.setSignedSat(true)
+		}
+		return z.Neg(z)
+	}
+	if z.isNeg() {
+		return z.setSignedSat(false)
+	}
+	return z
+}
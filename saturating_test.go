@@ -0,0 +1,36 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "testing"
+
+// TestSaturatingLimits runs the saturating operations on all pairs of values
+// around the limits of the unsigned and signed ranges, where random inputs
+// rarely go.
+func TestSaturatingLimits(t *testing.T) {
+	var (
+		max    = new(Int).SetAllOne()
+		minS   = (*Int)(&MinInt256)
+		maxS   = (*Int)(&MaxInt256)
+		values = []*Int{
+			new(Int), NewInt(1), NewInt(2), NewInt(255), NewInt(256), NewInt(257),
+			max, new(Int).SubUint64(max, 1),
+			minS, new(Int).AddUint64(minS, 1),
+			maxS, new(Int).SubUint64(maxS, 1),
+			new(Int).Rsh(max, 128), new(Int).Lsh(max, 128),
+		}
+	)
+	for _, name := range []string{
+		"AddSat", "AddUint64Sat", "SubSat", "SubUint64Sat", "MulSat", "LshSat", "ExpSat",
+		"SAddSat", "SSubSat", "SMulSat", "SLshSat", "SExpSat",
+	} {
+		tc := lookupBinary(name)
+		for _, x := range values {
+			for _, y := range values {
+				checkBinaryOperation(t, tc.name, tc.u256Fn, tc.bigFn, *x, *y)
+			}
+		}
+	}
+}
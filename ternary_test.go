@@ -31,6 +31,54 @@ var ternaryOpFuncs = []struct {
 		mu := Reciprocal(mod)
 		return z.Set(x.Clone().IMulModWithReciprocal(y, mod, &mu))
 	}, bigMulMod},
+	{"SubMod", (*Int).SubMod, bigSubMod},
+	{"ISubMod", func(z *Int, x *Int, y *Int, m *Int) *Int {
+		return z.Set(x.Clone().ISubMod(y, m))
+	}, bigSubMod},
+	{"NegMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.NegMod(x, m)
+	}, bigNegMod},
+	{"INegMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.Set(x.Clone().INegMod(m))
+	}, bigNegMod},
+	{"SquareMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.SquareMod(x, m)
+	}, bigSquareMod},
+	{"ISquareMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.Set(x.Clone().ISquareMod(m))
+	}, bigSquareMod},
+	{"DoubleMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.DoubleMod(x, m)
+	}, bigDoubleMod},
+	{"IDoubleMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.Set(x.Clone().IDoubleMod(m))
+	}, bigDoubleMod},
+	{"HalveMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.HalveMod(x, m)
+	}, bigHalveMod},
+	{"IHalveMod", func(z *Int, x *Int, _ *Int, m *Int) *Int {
+		return z.Set(x.Clone().IHalveMod(m))
+	}, bigHalveMod},
+	{"MulAddMod", mulAddMod, bigMulAddMod},
+	{"IMulAddMod", func(z *Int, x *Int, y *Int, m *Int) *Int {
+		a := new(Int).Xor(x, y)
+		return z.Set(x.Clone().IMulAddMod(y, a, m))
+	}, bigMulAddMod},
+	{"ExpMod", (*Int).ExpMod, bigExpMod},
+	{"IExpMod", func(z *Int, x *Int, y *Int, m *Int) *Int {
+		return z.Set(x.Clone().IExpMod(y, m))
+	}, bigExpMod},
+	{"MulDivRoundFloor", mulDivRound(Floor), bigMulDivRound(Floor)},
+	{"MulDivRoundCeil", mulDivRound(Ceil), bigMulDivRound(Ceil)},
+	{"MulDivRoundHalfUp", mulDivRound(HalfUp), bigMulDivRound(HalfUp)},
+	{"MulDivRoundHalfEven", mulDivRound(HalfEven), bigMulDivRound(HalfEven)},
+	{"MulDivRoundHalfDown", mulDivRound(HalfDown), bigMulDivRound(HalfDown)},
+	{"MulShr", mulShr, bigMulShr},
+	{"MulShrRoundFloor", mulShrRound(Floor), bigMulShrRound(Floor)},
+	{"MulShrRoundCeil", mulShrRound(Ceil), bigMulShrRound(Ceil)},
+	{"MulShrRoundHalfUp", mulShrRound(HalfUp), bigMulShrRound(HalfUp)},
+	{"MulShrRoundHalfEven", mulShrRound(HalfEven), bigMulShrRound(HalfEven)},
+	{"MulShrRoundHalfDown", mulShrRound(HalfDown), bigMulShrRound(HalfDown)},
 	{"DivModZ", divModZ, bigDivModZ},
 	{"DivModM", divModM, bigDivModM},
 }
@@ -128,6 +176,58 @@ func bigMulMod(result, x, y, mod *big.Int) *big.Int {
 	return result.Mod(result.Mul(x, y), mod)
 }
 
+func bigSubMod(result, x, y, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	return result.Mod(result.Sub(x, y), mod)
+}
+
+func bigNegMod(result, x, _, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	return result.Mod(result.Neg(x), mod)
+}
+
+func bigSquareMod(result, x, _, mod *big.Int) *big.Int {
+	return bigMulMod(result, x, x, mod)
+}
+
+func bigDoubleMod(result, x, _, mod *big.Int) *big.Int {
+	return bigAddMod(result, x, x, mod)
+}
+
+// bigHalveMod returns x * 2^-1 mod m, or 0 if m is even
+func bigHalveMod(result, x, _, mod *big.Int) *big.Int {
+	if mod.Bit(0) == 0 {
+		return result.SetUint64(0)
+	}
+	inv := new(big.Int).Rsh(new(big.Int).Add(mod, big.NewInt(1)), 1)
+	return result.Mod(result.Mul(x, inv), mod)
+}
+
+// mulAddMod wraps MulAddMod, taking the addend as x^y
+func mulAddMod(z, x, y, m *Int) *Int {
+	a := new(Int).Xor(x, y)
+	return z.MulAddMod(x, y, a, m)
+}
+
+func bigMulAddMod(result, x, y, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	a := new(big.Int).Xor(x, y)
+	return result.Mod(result.Add(result.Mul(x, y), a), mod)
+}
+
+func bigExpMod(result, base, exponent, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	return result.Exp(base, exponent, mod)
+}
+
 func (z *Int) mulModWithReciprocalWrapper(x, y, mod *Int) *Int {
 	mu := Reciprocal(mod)
 	return z.MulModWithReciprocal(x, y, mod, &mu)
@@ -163,3 +263,47 @@ func bigDivModM(result, x, y, mod *big.Int) *big.Int {
 	_, m2 := result.DivMod(x, y, mod)
 	return result.Set(m2)
 }
+
+// mulDivRound wraps MulDivRound with the given rounding mode, and returns the
+// result mod 2^256
+func mulDivRound(mode RoundingMode) opThreeArgFunc {
+	return func(z, x, y, d *Int) *Int {
+		z, _ = z.MulDivRound(x, y, d, mode)
+		return z
+	}
+}
+
+// bigMulDivRound implements MulDivRound on big.Int: returns 0 when dividing by 0
+func bigMulDivRound(mode RoundingMode) bigThreeArgFunc {
+	return func(z, x, y, d *big.Int) *big.Int {
+		return bigDivRound(mode)(z, new(big.Int).Mul(x, y), d)
+	}
+}
+
+// mulShr wraps MulShr, taking the shift from the low bits of the third
+// argument, and returns the result mod 2^256
+func mulShr(z, x, y, n *Int) *Int {
+	z, _ = z.MulShr(x, y, uint(n[0]%520))
+	return z
+}
+
+func bigMulShr(z, x, y, n *big.Int) *big.Int {
+	return z.Rsh(z.Mul(x, y), uint(n.Uint64()%520))
+}
+
+// mulShrRound wraps MulShrRound with the given rounding mode, taking the
+// shift from the low bits of the third argument, and returns the result
+// mod 2^256
+func mulShrRound(mode RoundingMode) opThreeArgFunc {
+	return func(z, x, y, n *Int) *Int {
+		z, _ = z.MulShrRound(x, y, uint(n[0]%520), mode)
+		return z
+	}
+}
+
+func bigMulShrRound(mode RoundingMode) bigThreeArgFunc {
+	return func(z, x, y, n *big.Int) *big.Int {
+		d := new(big.Int).Lsh(big.NewInt(1), uint(n.Uint64()%520))
+		return bigDivRound(mode)(z, new(big.Int).Mul(x, y), d)
+	}
+}
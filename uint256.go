@@ -319,6 +319,80 @@ func (z *Int) IAddMod(x, m *Int) *Int {
 	return z.AddMod(z, x, m)
 }
 
+// SubMod sets z to the difference ( x-y ) mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) SubMod(x, y, m *Int) *Int {
+	if m.IsZero() {
+		return z.Clear()
+	}
+	var xr, yr, res Int
+	xr.Mod(x, m)
+	yr.Mod(y, m)
+	// Both operands are below m, so adding m once on borrow is enough
+	if _, borrow := res.SubOverflow(&xr, &yr); borrow {
+		res.Add(&res, m)
+	}
+	return z.Set(&res)
+}
+
+// ISubMod subtracts x from z itself modulo m, modifying z in place, and returns z.
+// Mathematically: z = (z - x) mod m.
+func (z *Int) ISubMod(x, m *Int) *Int {
+	return z.SubMod(z, x, m)
+}
+
+// NegMod sets z to the additive inverse -x mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) NegMod(x, m *Int) *Int {
+	return z.SubMod(new(Int), x, m)
+}
+
+// INegMod negates z modulo m, modifying z in place, and returns z.
+// Mathematically: z = -z mod m.
+func (z *Int) INegMod(m *Int) *Int {
+	return z.NegMod(z, m)
+}
+
+// DoubleMod sets z to ( 2*x ) mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) DoubleMod(x, m *Int) *Int {
+	return z.AddMod(x, x, m)
+}
+
+// IDoubleMod doubles z modulo m, modifying z in place, and returns z.
+// Mathematically: z = (2 * z) mod m.
+func (z *Int) IDoubleMod(m *Int) *Int {
+	return z.DoubleMod(z, m)
+}
+
+// HalveMod sets z to ( x/2 ) mod m, the value such that 2*z = x mod m, and
+// returns z.
+// If m is even, 2 has no inverse modulo m and z is set to 0; this includes
+// m == 0 (OBS: differs from the big.Int)
+func (z *Int) HalveMod(x, m *Int) *Int {
+	if m[0]&1 == 0 {
+		return z.Clear()
+	}
+	var r Int
+	r.Mod(x, m)
+	if r[0]&1 == 0 {
+		return z.Rsh(&r, 1)
+	}
+	// r and m are both odd, so r+m is even; the carry becomes the top bit
+	_, carry := r.AddOverflow(&r, m)
+	r.Rsh(&r, 1)
+	if carry {
+		r[3] |= 1 << 63
+	}
+	return z.Set(&r)
+}
+
+// IHalveMod halves z modulo m, modifying z in place, and returns z.
+// Mathematically: z = (z / 2) mod m.
+func (z *Int) IHalveMod(m *Int) *Int {
+	return z.HalveMod(z, m)
+}
+
 // AddUint64 sets z to x + y, where y is a uint64, and returns z
 func (z *Int) AddUint64(x *Int, y uint64) *Int {
 	var carry uint64
@@ -524,8 +598,8 @@ func subMulTo(x, y []uint64, multiplier uint64) uint64 {
 
 // udivremBy1 divides u by single normalized word d and produces both quotient and remainder.
 // The quotient is stored in provided quot.
-func udivremBy1(quot, u []uint64, d uint64) (rem uint64) {
-	reciprocal := reciprocal2by1(d)
+// It uses the provided d's reciprocal.
+func udivremBy1(quot, u []uint64, d, reciprocal uint64) (rem uint64) {
 	rem = u[len(u)-1] // Set the top word as remainder.
 	for j := len(u) - 2; j >= 0; j-- {
 		quot[j], rem = udivrem2by1(rem, u[j], d, reciprocal)
@@ -536,10 +610,10 @@ func udivremBy1(quot, u []uint64, d uint64) (rem uint64) {
 // udivremKnuth implements the division of u by normalized multiple word d from the Knuth's division algorithm.
 // The quotient is stored in provided quot - len(u)-len(d) words.
 // Updates u to contain the remainder - len(d) words.
-func udivremKnuth(quot, u, d []uint64) {
+// It uses the provided reciprocal of d's most significant word.
+func udivremKnuth(quot, u, d []uint64, reciprocal uint64) {
 	dh := d[len(d)-1]
 	dl := d[len(d)-2]
-	reciprocal := reciprocal2by1(dh)
 
 	for j := len(u) - len(d) - 1; j >= 0; j-- {
 		u2 := u[j+len(d)]
@@ -619,14 +693,14 @@ func udivrem(quot, u []uint64, d, rem *Int) {
 	// TODO: Skip the highest word of numerator if not significant.
 
 	if dLen == 1 {
-		r := udivremBy1(quot, un, dn[0])
+		r := udivremBy1(quot, un, dn[0], reciprocal2by1(dn[0]))
 		if rem != nil {
 			rem.SetUint64(r >> shift)
 		}
 		return
 	}
 
-	udivremKnuth(quot, un, dn)
+	udivremKnuth(quot, un, dn, reciprocal2by1(dn[dLen-1]))
 
 	if rem != nil {
 		for i := 0; i < dLen-1; i++ {
@@ -767,27 +841,7 @@ func (z *Int) MulModWithReciprocal(x, y, m *Int, mu *[5]uint64) *Int {
 	}
 	var p [8]uint64
 	umul(x, y, &p)
-
-	if m[3] != 0 {
-		return z.reduce4(&p, m, mu)
-	}
-
-	var (
-		pl Int
-		ph Int
-	)
-	pl[0], pl[1], pl[2], pl[3] = p[0], p[1], p[2], p[3]
-	ph[0], ph[1], ph[2], ph[3] = p[4], p[5], p[6], p[7]
-
-	// If the multiplication is within 256 bits use Mod().
-	if ph.IsZero() {
-		return z.Mod(&pl, m)
-	}
-
-	var quot [8]uint64
-	var rem Int
-	udivrem(quot[:], p[:], m, &rem)
-	return z.Set(&rem)
+	return z.reduce(&p, m, mu)
 }
 
 // IMulModWithReciprocal calculates the modulo-m multiplication of z and x,
@@ -836,6 +890,50 @@ func (z *Int) IMulMod(x, m *Int) *Int {
 	return z.MulMod(z, x, m)
 }
 
+// SquareMod sets z to ( x*x ) mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) SquareMod(x, m *Int) *Int {
+	return z.MulMod(x, x, m)
+}
+
+// ISquareMod squares z modulo m, modifying z in place, and returns z.
+// Mathematically: z = (z * z) mod m.
+func (z *Int) ISquareMod(m *Int) *Int {
+	return z.MulMod(z, z, m)
+}
+
+// MulAddMod calculates the modulo-m value of x*y + a, using the full 512-bit
+// intermediate, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) MulAddMod(x, y, a, m *Int) *Int {
+	if m.IsZero() {
+		return z.Clear()
+	}
+	var (
+		p     [8]uint64
+		carry uint64
+	)
+	umul(x, y, &p)
+	// x*y + a <= (2^256-1)^2 + 2^256-1 < 2^512, so the sum fits in 8 words
+	p[0], carry = bits.Add64(p[0], a[0], 0)
+	p[1], carry = bits.Add64(p[1], a[1], carry)
+	p[2], carry = bits.Add64(p[2], a[2], carry)
+	p[3], carry = bits.Add64(p[3], a[3], carry)
+	p[4], carry = bits.Add64(p[4], 0, carry)
+	p[5], carry = bits.Add64(p[5], 0, carry)
+	p[6], carry = bits.Add64(p[6], 0, carry)
+	p[7], _ = bits.Add64(p[7], 0, carry)
+
+	mu := Reciprocal(m)
+	return z.reduce(&p, m, &mu)
+}
+
+// IMulAddMod multiplies z by x and adds a, modulo m, modifying z in place, and
+// returns z. Mathematically: z = (z * x + a) % m.
+func (z *Int) IMulAddMod(x, a, m *Int) *Int {
+	return z.MulAddMod(z, x, a, m)
+}
+
 // MulDivOverflow calculates (x*y)/d with full precision, returns z and whether overflow occurred in multiply process (result does not fit to 256-bit).
 // computes 512-bit multiplication and 512 by 256 division.
 func (z *Int) MulDivOverflow(x, y, d *Int) (*Int, bool) {
@@ -1279,6 +1377,78 @@ func (z *Int) ISRsh(n uint) *Int {
 	return z.SRsh(z, n)
 }
 
+// shiftAmount returns n as a shift count, capped at 256: all shifts by 256
+// bits or more have the same result.
+func shiftAmount(n *Int) uint {
+	if n[3]|n[2]|n[1] != 0 || n[0] > 256 {
+		return 256
+	}
+	return uint(n[0])
+}
+
+// LshInt sets z = x << n and returns z, where the shift count n is an Int.
+// If n >= 256, z is set to 0, as by the EVM SHL opcode.
+func (z *Int) LshInt(x, n *Int) *Int {
+	return z.Lsh(x, shiftAmount(n))
+}
+
+// ILshInt shifts z left by n bits, where n is an Int, modifying z in place,
+// and returns z. Mathematically: z = z << n.
+func (z *Int) ILshInt(n *Int) *Int {
+	return z.Lsh(z, shiftAmount(n))
+}
+
+// LshIntOverflow sets z = x << n, where the shift count n is an Int, and
+// returns z and true if any set bits were shifted out.
+func (z *Int) LshIntOverflow(x, n *Int) (*Int, bool) {
+	shift := shiftAmount(n)
+	overflow := uint(x.BitLen())+shift > 256
+	return z.Lsh(x, shift), overflow
+}
+
+// RshInt sets z = x >> n and returns z, where the shift count n is an Int.
+// If n >= 256, z is set to 0, as by the EVM SHR opcode.
+func (z *Int) RshInt(x, n *Int) *Int {
+	return z.Rsh(x, shiftAmount(n))
+}
+
+// IRshInt shifts z right by n bits, where n is an Int, modifying z in place,
+// and returns z. Mathematically: z = z >> n.
+func (z *Int) IRshInt(n *Int) *Int {
+	return z.Rsh(z, shiftAmount(n))
+}
+
+// RshIntOverflow sets z = x >> n, where the shift count n is an Int, and
+// returns z and true if any set bits were shifted out.
+func (z *Int) RshIntOverflow(x, n *Int) (*Int, bool) {
+	shift := shiftAmount(n)
+	overflow := uint(x.TrailingZeros()) < shift
+	return z.Rsh(x, shift), overflow
+}
+
+// SRshInt considers x to be a signed integer, sets z = x >> n and returns z,
+// where the shift count n is an Int. If n >= 256, z is set to 0 for
+// non-negative x and to -1 for negative x, as by the EVM SAR opcode.
+func (z *Int) SRshInt(x, n *Int) *Int {
+	return z.SRsh(x, shiftAmount(n))
+}
+
+// ISRshInt performs a signed right shift on z by n bits, where n is an Int,
+// modifying z in place, and returns z.
+// Mathematically: z = z >> n (where z is treated as a signed integer).
+func (z *Int) ISRshInt(n *Int) *Int {
+	return z.SRsh(z, shiftAmount(n))
+}
+
+// SRshIntOverflow considers x to be a signed integer, sets z = x >> n, where
+// the shift count n is an Int, and returns z and true if any set bits were
+// shifted out.
+func (z *Int) SRshIntOverflow(x, n *Int) (*Int, bool) {
+	shift := shiftAmount(n)
+	overflow := uint(x.TrailingZeros()) < shift
+	return z.SRsh(x, shift), overflow
+}
+
 // Set sets z to x and returns z.
 func (z *Int) Set(x *Int) *Int {
 	z[0], z[1], z[2], z[3] = x[0], x[1], x[2], x[3]
@@ -1331,56 +1501,150 @@ func (z *Int) Byte(n *Int) *Int {
 
 // Exp sets z = base**exponent mod 2**256, and returns z.
 func (z *Int) Exp(base, exponent *Int) *Int {
-	var (
-		res        = Int{1, 0, 0, 0}
-		multiplier = *base
-		expBitLen  = exponent.BitLen()
-		curBit     = 0
-		word       = exponent[0]
-		even       = base[0]&1 == 0
-	)
-	if even && expBitLen > 8 {
+	expBitLen := exponent.BitLen()
+	switch {
+	case expBitLen == 0:
+		return z.SetOne()
+	case expBitLen == 1:
+		return z.Set(base)
+	case base.IsZero():
 		return z.Clear()
 	}
-
-	for ; curBit < expBitLen && curBit < 64; curBit++ {
-		if word&1 == 1 {
-			res.Mul(&res, &multiplier)
+	if base[0]&1 == 0 {
+		// An even base to the power of 256 or more is a multiple of 2^256.
+		if expBitLen > 8 {
+			return z.Clear()
+		}
+		// A power of two is a single shift.
+		if n := uint(base.TrailingZeros()); base.BitLen() == int(n)+1 {
+			if shift := n * uint(exponent[0]); shift < 256 {
+				return z.Lsh(z.SetOne(), shift)
+			}
+			return z.Clear()
 		}
-		multiplier.squared()
-		word >>= 1
 	}
-	if even { // If the base was even, we are finished now
-		return z.Set(&res)
+	if expBitLen > expWindowMinBits {
+		return z.expWindow(base, exponent, expBitLen)
 	}
+	var (
+		res        = Int{1, 0, 0, 0}
+		multiplier = *base
+	)
+	expBits(&res, &multiplier, exponent, expBitLen)
+	return z.Set(&res)
+}
+
+const (
+	// expWindowMinBits is the exponent length above which Exp uses a sliding
+	// window; shorter exponents are faster with square-and-multiply.
+	expWindowMinBits = 64
+	// expWindowBits is the maximum width of a window.
+	expWindowBits = 4
+)
 
-	word = exponent[1]
-	for ; curBit < expBitLen && curBit < 128; curBit++ {
-		if word&1 == 1 {
-			res.Mul(&res, &multiplier)
+// expBits multiplies res by multiplier**(exponent mod 2**n), and squares the
+// multiplier n times. It is right-to-left square-and-multiply, where the
+// multiplications into res and the squarings of multiplier are independent,
+// and overlap in the CPU.
+func expBits(res, multiplier, exponent *Int, n int) {
+	for i := 0; i < n; i += 64 {
+		word := exponent[i/64]
+		for j := i; j < n && j < i+64; j++ {
+			if word&1 == 1 {
+				res.Mul(res, multiplier)
+			}
+			multiplier.squared()
+			word >>= 1
 		}
-		multiplier.squared()
-		word >>= 1
 	}
+}
 
-	word = exponent[2]
-	for ; curBit < expBitLen && curBit < 192; curBit++ {
-		if word&1 == 1 {
-			res.Mul(&res, &multiplier)
+// expWindow sets z = base**exponent mod 2**256, where the exponent has n > 0
+// bits, and returns z. It is left-to-right sliding window exponentiation: the
+// exponent is split into windows of up to expWindowBits bits which start and
+// end with a one bit, separated by zero bits. Each window costs a single
+// multiplication by a precomputed odd power of base, where square-and-multiply
+// needs one for every one bit.
+func (z *Int) expWindow(base, exponent *Int, n int) *Int {
+	// table[k] = base**(2k+1)
+	var (
+		table  [1 << (expWindowBits - 1)]Int
+		square = *base
+		res    Int
+	)
+	square.squared()
+	table[0] = *base
+	for k := 1; k < len(table); k++ {
+		table[k].Mul(&table[k-1], &square)
+	}
+	for i := n - 1; i >= 0; {
+		if exponent.Bit(uint(i)) == 0 {
+			res.squared()
+			i--
+			continue
+		}
+		// The window is the bits i down to l, where l is the lowest one bit
+		// within reach
+		l := i - expWindowBits + 1
+		if l < 0 {
+			l = 0
+		}
+		for exponent.Bit(uint(l)) == 0 {
+			l++
 		}
-		multiplier.squared()
-		word >>= 1
+		var window uint
+		for j := i; j >= l; j-- {
+			window = window<<1 | exponent.Bit(uint(j))
+		}
+		if i == n-1 {
+			// The first window starts at the top bit, and sets res
+			res = table[window>>1]
+		} else {
+			for j := i; j >= l; j-- {
+				res.squared()
+			}
+			res.Mul(&res, &table[window>>1])
+		}
+		i = l - 1
 	}
+	return z.Set(&res)
+}
 
-	word = exponent[3]
-	for ; curBit < expBitLen && curBit < 256; curBit++ {
-		if word&1 == 1 {
-			res.Mul(&res, &multiplier)
+// ExpOverflow sets z = base**exponent mod 2**256, and returns z and whether
+// the true result base**exponent is 2**256 or more. 0**0 is 1, without
+// overflow.
+func (z *Int) ExpOverflow(base, exponent *Int) (*Int, bool) {
+	// The bases 0 and 1 never overflow, and every other base does from an
+	// exponent of 256.
+	if base.LtUint64(2) || exponent.IsZero() {
+		return z.Exp(base, exponent), false
+	}
+	if !exponent.LtUint64(256) {
+		return z.Exp(base, exponent), true
+	}
+	e := exponent[0]
+	if n := uint(base.TrailingZeros()); base.BitLen() == int(n)+1 {
+		return z.Exp(base, exponent), n*uint(e) >= 256
+	}
+	// The intermediate results are powers of base no larger than the
+	// result, so the result overflows iff any of the steps does. The
+	// truncated products stay correct mod 2**256 once it has.
+	var (
+		b        = *base
+		res      = b
+		overflow bool
+	)
+	for i := bits.Len64(e) - 2; i >= 0; i-- {
+		if _, o := res.MulOverflow(&res, &res); o {
+			overflow = true
+		}
+		if (e>>uint(i))&1 == 1 {
+			if _, o := res.MulOverflow(&res, &b); o {
+				overflow = true
+			}
 		}
-		multiplier.squared()
-		word >>= 1
 	}
-	return z.Set(&res)
+	return z.Set(&res), overflow
 }
 
 // IExp sets z = z**exponent mod 2**256, and returns z.
@@ -1388,6 +1652,44 @@ func (z *Int) IExp(exponent *Int) *Int {
 	return z.Exp(z, exponent)
 }
 
+// ExpMod sets z = base**exponent mod m, and returns z.
+// The reciprocal of m is computed once, and used for all the multiplications.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) ExpMod(base, exponent, m *Int) *Int {
+	if m.IsZero() || m.isOne() {
+		return z.Clear()
+	}
+	var (
+		mu = Reciprocal(m)
+		b  Int
+	)
+	b.Mod(base, m)
+	if b.IsZero() && !exponent.IsZero() {
+		return z.Clear()
+	}
+	return z.expModWithReciprocal(&b, exponent, m, &mu)
+}
+
+// expModWithReciprocal sets z = base**exponent mod m, and returns z. The base
+// must be reduced modulo m > 1, and mu must be the reciprocal of m.
+func (z *Int) expModWithReciprocal(base, exponent, m *Int, mu *[5]uint64) *Int {
+	res := Int{1, 0, 0, 0}
+	// Left-to-right binary exponentiation
+	for i := exponent.BitLen() - 1; i >= 0; i-- {
+		res.MulModWithReciprocal(&res, &res, m, mu)
+		if (exponent[i/64]>>uint(i%64))&1 == 1 {
+			res.MulModWithReciprocal(&res, base, m, mu)
+		}
+	}
+	return z.Set(&res)
+}
+
+// IExpMod sets z = z**exponent mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) IExpMod(exponent, m *Int) *Int {
+	return z.ExpMod(z, exponent, m)
+}
+
 // ExtendSign extends length of two’s complement signed integer,
 // sets z to
 //   - x if byteNum > 30
@@ -1531,6 +1833,126 @@ func (z *Int) Log10() uint {
 	return uint(t)
 }
 
+// Log10Ceil returns the log in base 10, rounded up to the nearest integer.
+// **OBS** This method returns '0' for '0', not `-Inf`.
+func (z *Int) Log10Ceil() uint {
+	t := z.Log10()
+	if p := pow10(uint8(t)); z.Gt(&p) {
+		return t + 1
+	}
+	return t
+}
+
+// Log2 returns the log in base 2, floored to nearest integer.
+// **OBS** This method returns '0' for '0', not `-Inf`.
+func (z *Int) Log2() uint {
+	if bitlen := z.BitLen(); bitlen > 0 {
+		return uint(bitlen - 1)
+	}
+	return 0
+}
+
+// Log2Ceil returns the log in base 2, rounded up to the nearest integer.
+// **OBS** This method returns '0' for '0', not `-Inf`.
+func (z *Int) Log2Ceil() uint {
+	t := z.Log2()
+	if z.isPowerOfTwo() || z.IsZero() {
+		return t
+	}
+	return t + 1
+}
+
+// Log256 returns the log in base 256, floored to nearest integer. It is one
+// less than the number of bytes of z.
+// **OBS** This method returns '0' for '0', not `-Inf`.
+func (z *Int) Log256() uint {
+	return z.Log2() / 8
+}
+
+// Log256Ceil returns the log in base 256, rounded up to the nearest integer.
+// **OBS** This method returns '0' for '0', not `-Inf`.
+func (z *Int) Log256Ceil() uint {
+	return (z.Log2Ceil() + 7) / 8
+}
+
+// LogBase returns the log of z in base b, floored to nearest integer.
+// **OBS** This method returns '0' for '0', not `-Inf`, and '0' for a base
+// b < 2, for which the log is not defined.
+func (z *Int) LogBase(b *Int) uint {
+	t, _ := z.logBase(b)
+	return t
+}
+
+// LogBaseCeil returns the log of z in base b, rounded up to the nearest
+// integer.
+// **OBS** This method returns '0' for '0', not `-Inf`, and '0' for a base
+// b < 2, for which the log is not defined.
+func (z *Int) LogBaseCeil(b *Int) uint {
+	if z.IsZero() || b.LtUint64(2) {
+		return 0
+	}
+	t, exact := z.logBase(b)
+	if exact {
+		return t
+	}
+	return t + 1
+}
+
+// IsPowerOf returns true if z == b**k for some integer k >= 0. Every base
+// has 1 as its 0th power, 0 is a power of 0 only, and 1 of itself only.
+func (z *Int) IsPowerOf(b *Int) bool {
+	if b.LtUint64(2) {
+		return z.isOne() || z.Eq(b)
+	}
+	_, exact := z.logBase(b)
+	return exact
+}
+
+// logBase returns the floored log of z in base b, and whether z is an exact
+// power of b. z == 0 and b < 2 return (0, false).
+func (z *Int) logBase(b *Int) (uint, bool) {
+	if z.IsZero() || b.LtUint64(2) {
+		return 0, false
+	}
+	if b.isPowerOfTwo() {
+		// b = 2^k, so the log is log2(z) / k, exact if z is a power of two
+		// with a multiple of k trailing zeros.
+		k, t := b.Log2(), z.Log2()
+		return t / k, z.isPowerOfTwo() && t%k == 0
+	}
+	// powers[i] = b^(2^i), for the powers not above z. As b >= 3, the
+	// exponent is below 2^8.
+	var (
+		powers [8]Int
+		n      = 1
+	)
+	powers[0] = *b
+	for ; n < len(powers); n++ {
+		if _, overflow := powers[n].MulOverflow(&powers[n-1], &powers[n-1]); overflow || powers[n].Gt(z) {
+			break
+		}
+	}
+	// Build the largest power of b not above z from the top, one exponent
+	// bit at a time.
+	var (
+		acc = Int{1, 0, 0, 0}
+		t   uint
+		p   Int
+	)
+	for i := n - 1; i >= 0; i-- {
+		if _, overflow := p.MulOverflow(&acc, &powers[i]); !overflow && !p.Gt(z) {
+			acc = p
+			t |= 1 << uint(i)
+		}
+	}
+	return t, acc.Eq(z)
+}
+
+// isPowerOfTwo returns true if z has exactly one bit set
+func (z *Int) isPowerOfTwo() bool {
+	return bits.OnesCount64(z[0])+bits.OnesCount64(z[1])+bits.OnesCount64(z[2])+bits.OnesCount64(z[3]) == 1
+}
+
 // ReverseBytes sets z to the value of x with x's 32-byte representation reversed.
 // In other words, the following two are equivalent:
 // OPTION A:
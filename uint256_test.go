@@ -141,6 +141,31 @@ func runMulModCase(
 	}
 }
 
+func TestMulModWithReciprocalSmallModulus(t *testing.T) {
+	// Moduli of every word length exercise both reduction paths: operands
+	// less than the modulus, and full 256-bit operands.
+	for bitLen := 1; bitLen <= 192; bitLen++ {
+		for i := 0; i < 50; i++ {
+			bm, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), uint(bitLen)))
+			bm.SetBit(bm, bitLen-1, 1)
+			m := MustFromBig(bm)
+			mu := Reciprocal(m)
+			if mu == ([5]uint64{}) {
+				t.Fatalf("Reciprocal(%v) is zero", m.Hex())
+			}
+			x, y := randNum(), randNum()
+			if i%2 == 0 {
+				x.Mod(x, m)
+				y.Mod(y, m)
+			}
+			want := new(big.Int).Mod(new(big.Int).Mul(x.ToBig(), y.ToBig()), bm)
+			if have := new(Int).MulModWithReciprocal(x, y, m, &mu); !checkEq(want, have) {
+				t.Fatalf("MulModWithReciprocal(%v, %v, %v)\nwant : %#x\nhave : %#x", x.Hex(), y.Hex(), m.Hex(), want, have)
+			}
+		}
+	}
+}
+
 func TestRandomMulMod(t *testing.T) {
 	// Random tests (10,000 iterations) for both MulMod and IMulMod
 	for i := 0; i < 10000; i++ {
@@ -362,6 +387,43 @@ func TestRandomMulMod(t *testing.T) {
 	}
 }
 
+func TestRandomMulAddMod(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		b1, f1 := randNums()
+		b2, f2 := randNums()
+		b3, f3 := randNums()
+		b4, f4 := randNums()
+		if i%4 == 0 {
+			// Small moduli take the udivrem path
+			f4.Rsh(f4, uint(64*(i%16/4)+1))
+			b4 = f4.ToBig()
+		}
+		want := new(big.Int)
+		if b4.Sign() != 0 {
+			want.Mod(want.Add(want.Mul(b1, b2), b3), b4)
+		}
+		if have := new(Int).MulAddMod(f1, f2, f3, f4); !checkEq(want, have) {
+			t.Fatalf("MulAddMod(%x, %x, %x, %x)\nwant : %#x\nhave : %#x", f1, f2, f3, f4, want, have)
+		}
+		// The addend and modulus can be the receiver
+		a := f3.Clone()
+		if have := a.MulAddMod(f1, f2, a, f4); !checkEq(want, have) {
+			t.Fatalf("MulAddMod aliasing a (%x, %x, %x, %x)\nwant : %#x\nhave : %#x", f1, f2, f3, f4, want, have)
+		}
+		m := f4.Clone()
+		if have := m.MulAddMod(f1, f2, f3, m); !checkEq(want, have) {
+			t.Fatalf("MulAddMod aliasing m (%x, %x, %x, %x)\nwant : %#x\nhave : %#x", f1, f2, f3, f4, want, have)
+		}
+		// Halving undoes doubling for odd moduli
+		if f4[0]&1 == 1 {
+			x := new(Int).Mod(f1, f4)
+			if have := new(Int).DoubleMod(new(Int).HalveMod(x, f4), f4); !have.Eq(x) {
+				t.Fatalf("DoubleMod(HalveMod(%x, %x)): have %x", x, f4, have)
+			}
+		}
+	}
+}
+
 func TestRandomMulDivOverflow(t *testing.T) {
 	for i := 0; i < 10000; i++ {
 		b1, f1 := randNums()
@@ -448,6 +510,44 @@ func Test10KRandomRsh(t *testing.T)  { test10KRandom(t, "Rsh") }
 func Test10KRandomSRsh(t *testing.T) { test10KRandom(t, "SRsh") }
 func Test10KRandomExp(t *testing.T)  { test10KRandom(t, "Exp") }
 
+// TestExpEdges checks Exp and ExpOverflow on the bases and exponents of the
+// fast paths and around the folding of the high exponent bits.
+func TestExpEdges(t *testing.T) {
+	bases := []*Int{new(Int), NewInt(1), NewInt(2), NewInt(3), NewInt(10), NewInt(0x1000),
+		MustFromHex("0x100000000000000000000000000000001"),
+		MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000000"),
+		MustFromHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")}
+	for i := 0; i < 20; i++ {
+		bases = append(bases, randNum())
+	}
+	exps := []*Int{MustFromHex("0xffffffffffffffff"), MustFromHex("0x10000000000000000"), MustFromHex("0x1ffffffffffffffff"),
+		MustFromHex("0x10000000000000001"), MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000001"),
+		MustFromHex("0xa5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5"),
+		MustFromHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")}
+	for e := uint64(0); e < 300; e++ {
+		exps = append(exps, NewInt(e))
+	}
+	for i := 0; i < 20; i++ {
+		exps = append(exps, randNum())
+	}
+	for _, b := range bases {
+		for _, e := range exps {
+			bb, be := b.ToBig(), e.ToBig()
+			want := new(big.Int).Exp(bb, be, bigtt256)
+			if have := new(Int).Exp(b, e); have.ToBig().Cmp(want) != 0 {
+				t.Fatalf("Exp(%#x, %#x) = %#x, want %#x", b, e, have, want)
+			}
+			have, overflow := new(Int).ExpOverflow(b, e)
+			if have.ToBig().Cmp(want) != 0 {
+				t.Fatalf("ExpOverflow(%#x, %#x) = %#x, want %#x", b, e, have, want)
+			}
+			if wantOverflow := bigExpCapped(new(big.Int), bb, be).Cmp(bigtt256) >= 0; overflow != wantOverflow {
+				t.Fatalf("ExpOverflow(%#x, %#x) overflow = %v, want %v", b, e, overflow, wantOverflow)
+			}
+		}
+	}
+}
+
 func test10KRandom(t *testing.T, name string) {
 	tc := lookupBinary(name)
 	for i := 0; i < 10000; i++ {
@@ -486,6 +586,52 @@ func TestSRsh(t *testing.T) {
 	}
 }
 
+func TestShiftInt(t *testing.T) {
+	var (
+		max  = new(Int).SetAllOne()
+		minS = (*Int)(&MinInt256)
+	)
+	for _, n := range []*Int{
+		NewInt(0), NewInt(1), NewInt(63), NewInt(64), NewInt(255), NewInt(256), NewInt(257),
+		new(Int).Lsh(NewInt(1), 64), new(Int).AddUint64(new(Int).Lsh(NewInt(1), 64), 1), max,
+	} {
+		for _, x := range []*Int{new(Int), NewInt(1), max, minS, randNum(), randNum()} {
+			for _, name := range []string{"LshInt", "ILshInt", "RshInt", "IRshInt", "SRshInt", "ISRshInt"} {
+				tc := lookupBinary(name)
+				checkBinaryOperation(t, tc.name, tc.u256Fn, tc.bigFn, *x, *n)
+			}
+			var (
+				bx    = x.ToBig()
+				shift = bigShiftAmount(n.ToBig())
+				back  = new(big.Int)
+			)
+			if _, have := new(Int).LshIntOverflow(x, n); have != (back.Lsh(bx, shift).Cmp(bigtt256) >= 0) {
+				t.Errorf("LshIntOverflow(%v, %v): have %v", x.Hex(), n.Hex(), have)
+			}
+			back.Lsh(back.Rsh(bx, shift), shift)
+			if _, have := new(Int).RshIntOverflow(x, n); have != (back.Cmp(bx) != 0) {
+				t.Errorf("RshIntOverflow(%v, %v): have %v", x.Hex(), n.Hex(), have)
+			}
+			if _, have := new(Int).SRshIntOverflow(x, n); have != (back.Cmp(bx) != 0) {
+				t.Errorf("SRshIntOverflow(%v, %v): have %v", x.Hex(), n.Hex(), have)
+			}
+			// The results match the plain variants
+			z, _ := new(Int).LshIntOverflow(x, n)
+			if want := new(Int).LshInt(x, n); !z.Eq(want) {
+				t.Errorf("LshIntOverflow(%v, %v): have %v, want %v", x.Hex(), n.Hex(), z.Hex(), want.Hex())
+			}
+			z, _ = new(Int).RshIntOverflow(x, n)
+			if want := new(Int).RshInt(x, n); !z.Eq(want) {
+				t.Errorf("RshIntOverflow(%v, %v): have %v, want %v", x.Hex(), n.Hex(), z.Hex(), want.Hex())
+			}
+			z, _ = new(Int).SRshIntOverflow(x, n)
+			if want := new(Int).SRshInt(x, n); !z.Eq(want) {
+				t.Errorf("SRshIntOverflow(%v, %v): have %v, want %v", x.Hex(), n.Hex(), z.Hex(), want.Hex())
+			}
+		}
+	}
+}
+
 func TestByte(t *testing.T) {
 	input, err := FromHex("0x102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
 	if err != nil {
@@ -885,6 +1031,132 @@ func FuzzLog10(f *testing.F) {
 	})
 }
 
+// logBases are the bases of the LogBase tests, including the powers of two of
+// the fast path, bases above 64 bits and the undefined bases 0 and 1
+var logBases = []*Int{
+	NewInt(0), NewInt(1), NewInt(2), NewInt(3), NewInt(4), NewInt(7), NewInt(10), NewInt(16),
+	NewInt(256), NewInt(1000), NewInt(0xffffffffffffffff),
+	MustFromHex("0x10000000000000001"),
+	MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000000"),
+}
+
+func testLogs(t *testing.T, z *Int) {
+	t.Helper()
+	var (
+		bz   = z.ToBig()
+		bzm1 = new(big.Int).Sub(bz, big.NewInt(1))
+		want uint
+	)
+	check := func(name string, have, want uint) {
+		t.Helper()
+		if have != want {
+			t.Errorf("%s(%s): have %v want %v", name, z.Hex(), have, want)
+		}
+	}
+	if bz.Sign() > 0 {
+		want = uint(bz.BitLen() - 1)
+	}
+	check("Log2", z.Log2(), want)
+	want = 0
+	if bz.Cmp(big.NewInt(1)) > 0 {
+		want = uint(bzm1.BitLen())
+	}
+	check("Log2Ceil", z.Log2Ceil(), want)
+	want = 0
+	if bz.Sign() > 0 {
+		want = uint(len(bz.Bytes()) - 1)
+	}
+	check("Log256", z.Log256(), want)
+	want = 0
+	if bz.Cmp(big.NewInt(1)) > 0 {
+		want = uint(len(bzm1.Bytes()))
+	}
+	check("Log256Ceil", z.Log256Ceil(), want)
+	want = 0
+	if bz.Cmp(big.NewInt(1)) > 0 {
+		want = uint(len(bzm1.String()))
+	}
+	check("Log10Ceil", z.Log10Ceil(), want)
+	for _, b := range logBases {
+		testLogBase(t, z, b)
+	}
+}
+
+// testLogBase checks LogBase, LogBaseCeil and IsPowerOf against repeated
+// big.Int multiplication, and LogBase against a float estimate away from
+// integer results.
+func testLogBase(t *testing.T, z, b *Int) {
+	t.Helper()
+	var (
+		bz, bb    = z.ToBig(), b.ToBig()
+		p         = big.NewInt(1)
+		floor     uint
+		wantPower bool
+	)
+	if b.LtUint64(2) {
+		wantPower = z.IsUint64() && (z.Uint64() == 1 || z.Eq(b))
+	} else if bz.Sign() > 0 {
+		for new(big.Int).Mul(p, bb).Cmp(bz) <= 0 {
+			p.Mul(p, bb)
+			floor++
+		}
+		wantPower = p.Cmp(bz) == 0
+	}
+	ceil := floor
+	if !wantPower && bz.Sign() > 0 && !b.LtUint64(2) {
+		ceil++
+	}
+	if have := z.LogBase(b); have != floor {
+		t.Errorf("LogBase(%s, %s): have %v want %v", z.Hex(), b.Hex(), have, floor)
+	}
+	if have := z.LogBaseCeil(b); have != ceil {
+		t.Errorf("LogBaseCeil(%s, %s): have %v want %v", z.Hex(), b.Hex(), have, ceil)
+	}
+	if have := z.IsPowerOf(b); have != wantPower {
+		t.Errorf("IsPowerOf(%s, %s): have %v want %v", z.Hex(), b.Hex(), have, wantPower)
+	}
+	if bz.Sign() > 0 && !b.LtUint64(2) {
+		fz, _ := new(big.Float).SetInt(bz).Float64()
+		fb, _ := new(big.Float).SetInt(bb).Float64()
+		if l := math.Log(fz) / math.Log(fb); math.Abs(l-math.Round(l)) > 1e-9 && uint(l) != floor {
+			t.Errorf("LogBase(%s, %s): have %v, float estimate %v", z.Hex(), b.Hex(), floor, l)
+		}
+	}
+}
+
+func TestLogs(t *testing.T) {
+	testLogs(t, new(Int))
+	for i := uint(0); i < 256; i++ {
+		z := new(Int).Lsh(NewInt(1), i)
+		testLogs(t, z)
+		testLogs(t, new(Int).AddUint64(z, 1))
+		testLogs(t, new(Int).SubUint64(z, 1))
+	}
+	// Powers of every base, and their neighbours
+	for _, b := range logBases[2:] {
+		for z := NewInt(1); ; {
+			testLogs(t, z)
+			testLogs(t, new(Int).AddUint64(z, 1))
+			testLogs(t, new(Int).SubUint64(z, 1))
+			if _, overflow := z.MulOverflow(z, b); overflow {
+				break
+			}
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		testLogBase(t, randNum(), randNum())
+	}
+	testLogs(t, new(Int).SetAllOne())
+}
+
+func FuzzLogs(f *testing.F) {
+	f.Fuzz(func(t *testing.T, aa, bb, cc, dd, base uint64) {
+		z := &Int{aa, bb, cc, dd}
+		testLogs(t, z)
+		testLogBase(t, z, NewInt(base))
+	})
+}
+
 func BenchmarkLog10(b *testing.B) {
 	var u256Ints []*Int
 	var bigints []*big.Int
@@ -0,0 +1,243 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Uint512 is a 512-bit unsigned integer, represented as an array of 8 uint64,
+// in little-endian order: z[0] is the least significant word.
+// It holds full-width products of two Ints, and the quotients and remainders
+// of dividing such products by an Int.
+type Uint512 [8]uint64
+
+// SetInt sets z to x and returns z.
+func (z *Uint512) SetInt(x *Int) *Uint512 {
+	*z = Uint512{x[0], x[1], x[2], x[3]}
+	return z
+}
+
+// SetHiLo sets z to hi*2^256 + lo and returns z.
+func (z *Uint512) SetHiLo(hi, lo *Int) *Uint512 {
+	*z = Uint512{lo[0], lo[1], lo[2], lo[3], hi[0], hi[1], hi[2], hi[3]}
+	return z
+}
+
+// Clear sets z to 0
+func (z *Uint512) Clear() *Uint512 {
+	*z = Uint512{}
+	return z
+}
+
+// Lo returns the lower 256 bits of z.
+func (z *Uint512) Lo() *Int {
+	return &Int{z[0], z[1], z[2], z[3]}
+}
+
+// Hi returns the upper 256 bits of z.
+func (z *Uint512) Hi() *Int {
+	return &Int{z[4], z[5], z[6], z[7]}
+}
+
+// IsZero returns true if z == 0
+func (z *Uint512) IsZero() bool {
+	return *z == Uint512{}
+}
+
+// BitLen returns the number of bits required to represent z
+func (z *Uint512) BitLen() int {
+	for i := len(z) - 1; i >= 0; i-- {
+		if z[i] != 0 {
+			return i*64 + bits.Len64(z[i])
+		}
+	}
+	return 0
+}
+
+// Cmp compares z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Uint512) Cmp(x *Uint512) int {
+	for i := len(z) - 1; i >= 0; i-- {
+		switch {
+		case z[i] < x[i]:
+			return -1
+		case z[i] > x[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// Eq returns true if z == x
+func (z *Uint512) Eq(x *Uint512) bool {
+	return *z == *x
+}
+
+// Lt returns true if z < x
+func (z *Uint512) Lt(x *Uint512) bool {
+	return z.Cmp(x) < 0
+}
+
+// Gt returns true if z > x
+func (z *Uint512) Gt(x *Uint512) bool {
+	return z.Cmp(x) > 0
+}
+
+// MulFull sets z to the full 512-bit product x*y, and returns z.
+func (z *Uint512) MulFull(x, y *Int) *Uint512 {
+	umul(x, y, (*[8]uint64)(z))
+	return z
+}
+
+// Add sets z to the sum x+y mod 2^512, and returns z.
+func (z *Uint512) Add(x, y *Uint512) *Uint512 {
+	z.AddOverflow(x, y)
+	return z
+}
+
+// AddOverflow sets z to the sum x+y mod 2^512, and returns z and true if
+// the addition carried out of 512 bits.
+func (z *Uint512) AddOverflow(x, y *Uint512) (*Uint512, bool) {
+	var carry uint64
+	for i := range z {
+		z[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+	return z, carry != 0
+}
+
+// Sub sets z to the difference x-y mod 2^512, and returns z.
+func (z *Uint512) Sub(x, y *Uint512) *Uint512 {
+	z.SubOverflow(x, y)
+	return z
+}
+
+// SubOverflow sets z to the difference x-y mod 2^512, and returns z and true
+// if the subtraction borrowed, i.e. x < y.
+func (z *Uint512) SubOverflow(x, y *Uint512) (*Uint512, bool) {
+	var borrow uint64
+	for i := range z {
+		z[i], borrow = bits.Sub64(x[i], y[i], borrow)
+	}
+	return z, borrow != 0
+}
+
+// Lsh sets z = x << n mod 2^512 and returns z.
+func (z *Uint512) Lsh(x *Uint512, n uint) *Uint512 {
+	if n >= 512 {
+		return z.Clear()
+	}
+	var (
+		words = int(n / 64)
+		shift = n % 64
+		res   Uint512
+	)
+	for i := len(res) - 1; i >= words; i-- {
+		res[i] = x[i-words] << shift
+		if shift != 0 && i > words {
+			res[i] |= x[i-words-1] >> (64 - shift)
+		}
+	}
+	*z = res
+	return z
+}
+
+// Rsh sets z = x >> n and returns z.
+func (z *Uint512) Rsh(x *Uint512, n uint) *Uint512 {
+	if n >= 512 {
+		return z.Clear()
+	}
+	var (
+		words = int(n / 64)
+		shift = n % 64
+		res   Uint512
+	)
+	for i := 0; i+words < len(res); i++ {
+		res[i] = x[i+words] >> shift
+		if shift != 0 && i+words+1 < len(res) {
+			res[i] |= x[i+words+1] << (64 - shift)
+		}
+	}
+	*z = res
+	return z
+}
+
+// Div sets z to the quotient x/d, and returns z.
+// If d == 0, z is set to 0
+func (z *Uint512) Div(x *Uint512, d *Int) *Uint512 {
+	var quot Uint512
+	if !d.IsZero() {
+		udivrem(quot[:], x[:], d, nil)
+	}
+	*z = quot
+	return z
+}
+
+// ModUint512 sets z to the modulus x%d, and returns z.
+// If d == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) ModUint512(x *Uint512, d *Int) *Int {
+	var (
+		quot Uint512
+		rem  Int
+	)
+	if !d.IsZero() {
+		udivrem(quot[:], x[:], d, &rem)
+	}
+	return z.Set(&rem)
+}
+
+// DivMod sets z to the quotient x/d and m to the modulus x%d, and returns
+// the pair (z, m).
+// If d == 0, both z and m are set to 0 (OBS: differs from the big.Int)
+func (z *Uint512) DivMod(x *Uint512, d, m *Int) (*Uint512, *Int) {
+	var (
+		quot Uint512
+		rem  Int
+	)
+	if !d.IsZero() {
+		udivrem(quot[:], x[:], d, &rem)
+	}
+	*z = quot
+	m.Set(&rem)
+	return z, m
+}
+
+// SetFromUint512 sets z to the lower 256 bits of x, and returns true if x
+// does not fit in 256 bits.
+func (z *Int) SetFromUint512(x *Uint512) bool {
+	z[0], z[1], z[2], z[3] = x[0], x[1], x[2], x[3]
+	return (x[4] | x[5] | x[6] | x[7]) != 0
+}
+
+// ToBig returns a big.Int version of z.
+func (z *Uint512) ToBig() *big.Int {
+	b := z.Hi().ToBig()
+	b.Lsh(b, 256)
+	return b.Or(b, z.Lo().ToBig())
+}
+
+// SetFromBig sets z to the lower 512 bits of b, and returns true if b does
+// not fit in 512 bits. Negative values are set in two's complement.
+func (z *Uint512) SetFromBig(b *big.Int) bool {
+	var (
+		mask = new(big.Int).SetUint64(^uint64(0))
+		x    = new(big.Int).Abs(b)
+		w    = new(big.Int)
+	)
+	overflow := x.BitLen() > 512
+	for i := range z {
+		z[i] = w.And(x, mask).Uint64()
+		x.Rsh(x, 64)
+	}
+	if b.Sign() < 0 {
+		var zero Uint512
+		z.Sub(&zero, z)
+	}
+	return overflow
+}
@@ -0,0 +1,196 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+var (
+	bigtt512   = new(big.Int).Lsh(big.NewInt(1), 512)
+	bigtt512m1 = new(big.Int).Sub(bigtt512, big.NewInt(1))
+)
+
+// randUint512 returns a random 512-bit number, with a random number of leading
+// zero words in either half.
+func randUint512() *Uint512 {
+	return new(Uint512).SetHiLo(randNum(), randNum())
+}
+
+func checkUint512Div(t *testing.T, x *Uint512, d *Int) {
+	t.Helper()
+	var (
+		bx, bd    = x.ToBig(), d.ToBig()
+		wantQ     = new(big.Int)
+		wantR     = new(big.Int)
+		q, q2, q3 Uint512
+		r, r2     Int
+		xc, dc    = *x, *d
+	)
+	if bd.Sign() != 0 {
+		wantQ.DivMod(bx, bd, wantR)
+	}
+	q.Div(x, d)
+	r.ModUint512(x, d)
+	q2.DivMod(x, d, &r2)
+	if !xc.Eq(x) || !dc.Eq(d) {
+		t.Fatalf("Div(%#x, %v): arguments modified", bx, d.Hex())
+	}
+	if q.ToBig().Cmp(wantQ) != 0 || q2.ToBig().Cmp(wantQ) != 0 {
+		t.Fatalf("Div(%#x, %v)\nwant : %#x\nhave : %#x\nhave : %#x", bx, d.Hex(), wantQ, q.ToBig(), q2.ToBig())
+	}
+	if r.ToBig().Cmp(wantR) != 0 || r2.ToBig().Cmp(wantR) != 0 {
+		t.Fatalf("Mod(%#x, %v)\nwant : %#x\nhave : %#x\nhave : %#x", bx, d.Hex(), wantR, &r, &r2)
+	}
+	// Aliasing of the result with the dividend
+	q3 = *x
+	if q3.Div(&q3, d); !q3.Eq(&q) {
+		t.Fatalf("Div(%#x, %v): aliased result mismatch", bx, d.Hex())
+	}
+}
+
+func TestUint512MulFull(t *testing.T) {
+	check := func(x, y *Int) {
+		t.Helper()
+		want := new(big.Int).Mul(x.ToBig(), y.ToBig())
+		var z Uint512
+		if have := z.MulFull(x, y).ToBig(); have.Cmp(want) != 0 {
+			t.Fatalf("MulFull(%v, %v)\nwant : %#x\nhave : %#x", x.Hex(), y.Hex(), want, have)
+		}
+		if !z.Lo().Eq(new(Int).Mul(x, y)) {
+			t.Fatalf("MulFull(%v, %v): low half differs from Mul", x.Hex(), y.Hex())
+		}
+		var lo Int
+		overflow := lo.SetFromUint512(&z)
+		if !lo.Eq(z.Lo()) || overflow != !z.Hi().IsZero() {
+			t.Fatalf("SetFromUint512(%#x): have %v, overflow %v", want, lo.Hex(), overflow)
+		}
+	}
+	for _, inputs := range binTestCases {
+		x, y := MustFromHex(inputs[0]), MustFromHex(inputs[1])
+		check(x, y)
+	}
+	for i := 0; i < 10000; i++ {
+		check(randNum(), randNum())
+	}
+}
+
+func TestUint512AddSub(t *testing.T) {
+	check := func(x, y *Uint512) {
+		t.Helper()
+		bx, by := x.ToBig(), y.ToBig()
+
+		var z Uint512
+		_, overflow := z.AddOverflow(x, y)
+		want := new(big.Int).Add(bx, by)
+		if wantOverflow := want.Cmp(bigtt512m1) > 0; overflow != wantOverflow {
+			t.Fatalf("AddOverflow(%#x, %#x): overflow %v, want %v", bx, by, overflow, wantOverflow)
+		}
+		want.And(want, bigtt512m1)
+		if have := z.ToBig(); have.Cmp(want) != 0 {
+			t.Fatalf("AddOverflow(%#x, %#x)\nwant : %#x\nhave : %#x", bx, by, want, have)
+		}
+		if !new(Uint512).Add(x, y).Eq(&z) {
+			t.Fatalf("Add(%#x, %#x): differs from AddOverflow", bx, by)
+		}
+
+		_, overflow = z.SubOverflow(x, y)
+		if wantOverflow := bx.Cmp(by) < 0; overflow != wantOverflow {
+			t.Fatalf("SubOverflow(%#x, %#x): overflow %v, want %v", bx, by, overflow, wantOverflow)
+		}
+		want.Sub(bx, by).And(want, bigtt512m1)
+		if have := z.ToBig(); have.Cmp(want) != 0 {
+			t.Fatalf("SubOverflow(%#x, %#x)\nwant : %#x\nhave : %#x", bx, by, want, have)
+		}
+		if !new(Uint512).Sub(x, y).Eq(&z) {
+			t.Fatalf("Sub(%#x, %#x): differs from SubOverflow", bx, by)
+		}
+
+		if have, want := x.Cmp(y), bx.Cmp(by); have != want {
+			t.Fatalf("Cmp(%#x, %#x): have %d, want %d", bx, by, have, want)
+		}
+		if x.Lt(y) != (bx.Cmp(by) < 0) || x.Gt(y) != (bx.Cmp(by) > 0) || x.Eq(y) != (bx.Cmp(by) == 0) {
+			t.Fatalf("Lt/Gt/Eq(%#x, %#x) mismatch", bx, by)
+		}
+		if have, want := x.BitLen(), bx.BitLen(); have != want {
+			t.Fatalf("BitLen(%#x): have %d, want %d", bx, have, want)
+		}
+	}
+	var (
+		zero Uint512
+		max  = Uint512{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}
+		one  = Uint512{1}
+	)
+	check(&zero, &zero)
+	check(&max, &one)
+	check(&one, &max)
+	check(&max, &max)
+	for i := 0; i < 10000; i++ {
+		check(randUint512(), randUint512())
+	}
+}
+
+func TestUint512Shift(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		var (
+			x    = randUint512()
+			bx   = x.ToBig()
+			n    = uint(i % 520)
+			z    Uint512
+			want = new(big.Int)
+		)
+		want.And(want.Lsh(bx, n), bigtt512m1)
+		if z.Lsh(x, n); z.ToBig().Cmp(want) != 0 {
+			t.Fatalf("Lsh(%#x, %d)\nwant : %#x\nhave : %#x", bx, n, want, z.ToBig())
+		}
+		want.Rsh(bx, n)
+		if z.Rsh(x, n); z.ToBig().Cmp(want) != 0 {
+			t.Fatalf("Rsh(%#x, %d)\nwant : %#x\nhave : %#x", bx, n, want, z.ToBig())
+		}
+		// Aliasing of the result with the argument
+		z = *x
+		if z.Rsh(&z, n); z.ToBig().Cmp(want) != 0 {
+			t.Fatalf("Rsh(%#x, %d): aliased result mismatch", bx, n)
+		}
+	}
+}
+
+func TestUint512Div(t *testing.T) {
+	for _, inputs := range binTestCases {
+		x, y := MustFromHex(inputs[0]), MustFromHex(inputs[1])
+		var p Uint512
+		p.MulFull(x, x)
+		checkUint512Div(t, &p, y)
+		checkUint512Div(t, new(Uint512).SetInt(x), y)
+		checkUint512Div(t, new(Uint512).SetHiLo(y, x), y)
+	}
+	for i := 0; i < 10000; i++ {
+		checkUint512Div(t, randUint512(), randNum())
+	}
+}
+
+func TestUint512Big(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		x := randUint512()
+		var z Uint512
+		if overflow := z.SetFromBig(x.ToBig()); overflow || !z.Eq(x) {
+			t.Fatalf("SetFromBig(%#x): have %#x, overflow %v", x.ToBig(), z.ToBig(), overflow)
+		}
+	}
+	var z Uint512
+	if overflow := z.SetFromBig(bigtt512); !overflow || !z.IsZero() {
+		t.Fatalf("SetFromBig(2^512): have %#x, overflow %v", z.ToBig(), overflow)
+	}
+	if overflow := z.SetFromBig(big.NewInt(-1)); overflow || z.ToBig().Cmp(bigtt512m1) != 0 {
+		t.Fatalf("SetFromBig(-1): have %#x, overflow %v", z.ToBig(), overflow)
+	}
+}
+
+func FuzzUint512Div(f *testing.F) {
+	f.Fuzz(func(t *testing.T, x0, x1, x2, x3, x4, x5, x6, x7, d0, d1, d2, d3 uint64) {
+		checkUint512Div(t, &Uint512{x0, x1, x2, x3, x4, x5, x6, x7}, &Int{d0, d1, d2, d3})
+	})
+}
@@ -23,11 +23,59 @@ var unaryOpFuncs = []struct {
 	{"Sqrt", (*Int).Sqrt, (*big.Int).Sqrt},
 	{"ISqrt",
 		func(z *Int, x *Int) *Int { return z.Set(x.Clone().ISqrt()) }, (*big.Int).Sqrt},
+	{"SqrtCeil", (*Int).SqrtCeil, bigSqrtCeil},
+	{"SqrtRem", func(z *Int, x *Int) *Int {
+		var rem Int
+		z.SqrtRem(x, &rem)
+		return z.Set(&rem)
+	}, func(b1, b2 *big.Int) *big.Int {
+		s := new(big.Int).Sqrt(b2)
+		return b1.Sub(b2, s.Mul(s, s))
+	}},
+	{"IsPerfectSquare", func(z *Int, x *Int) *Int {
+		if x.IsPerfectSquare() {
+			return z.SetOne()
+		}
+		return z.Clear()
+	}, func(b1, b2 *big.Int) *big.Int {
+		s := new(big.Int).Sqrt(b2)
+		if s.Mul(s, s).Cmp(b2) == 0 {
+			return b1.SetUint64(1)
+		}
+		return b1.SetUint64(0)
+	}},
+	{"Cbrt", (*Int).Cbrt, func(b1, b2 *big.Int) *big.Int { return bigRoot(b1, b2, 3) }},
+	{"Root5", func(z *Int, x *Int) *Int { return z.Root(x, 5) },
+		func(b1, b2 *big.Int) *big.Int { return bigRoot(b1, b2, 5) }},
 	{"square", func(x *Int, y *Int) *Int {
 		res := y.Clone()
 		res.squared()
 		return x.Set(res)
 	}, func(b1, b2 *big.Int) *big.Int { return b1.Mul(b2, b2) }},
+	{"ReverseBits", (*Int).ReverseBits, func(b1, b2 *big.Int) *big.Int {
+		b1.SetUint64(0)
+		for i := 0; i < 256; i++ {
+			b1.SetBit(b1, 255-i, b2.Bit(i))
+		}
+		return b1
+	}},
+	{"LeadingZeros", func(z *Int, x *Int) *Int { return z.SetUint64(uint64(x.LeadingZeros())) },
+		func(b1, b2 *big.Int) *big.Int { return b1.SetUint64(uint64(256 - b2.BitLen())) }},
+	{"TrailingZeros", func(z *Int, x *Int) *Int { return z.SetUint64(uint64(x.TrailingZeros())) },
+		func(b1, b2 *big.Int) *big.Int {
+			if b2.Sign() == 0 {
+				return b1.SetUint64(256)
+			}
+			return b1.SetUint64(uint64(b2.TrailingZeroBits()))
+		}},
+	{"OnesCount", func(z *Int, x *Int) *Int { return z.SetUint64(uint64(x.OnesCount())) },
+		func(b1, b2 *big.Int) *big.Int {
+			var n uint64
+			for i := 0; i < b2.BitLen(); i++ {
+				n += uint64(b2.Bit(i))
+			}
+			return b1.SetUint64(n)
+		}},
 	{"Abs", (*Int).Abs, func(b1, b2 *big.Int) *big.Int { return b1.Abs(bigS256(b2)) }},
 	{"ReverseBytes", (*Int).ReverseBytes, func(b1, b2 *big.Int) *big.Int {
 		dest := make([]byte, 32)